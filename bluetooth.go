@@ -0,0 +1,42 @@
+package uuid
+
+import "encoding/binary"
+
+// BluetoothBase is the Bluetooth Base UUID, against which 16-bit and 32-bit
+// Bluetooth UUIDs are expanded: 00000000-0000-1000-8000-00805f9b34fb.
+var BluetoothBase = UUID{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0x80, 0x00, 0x00, 0x80, 0x5f, 0x9b, 0x34, 0xfb}
+
+// FromBluetooth16 returns the 128-bit UUID of the provided 16-bit Bluetooth
+// UUID, such as a GATT service or characteristic UUID.
+//
+// Example: 0x180d -> 0000180d-0000-1000-8000-00805f9b34fb
+func FromBluetooth16(v uint16) UUID {
+	return FromBluetooth32(uint32(v))
+}
+
+// FromBluetooth32 returns the 128-bit UUID of the provided 32-bit Bluetooth
+// UUID.
+func FromBluetooth32(v uint32) UUID {
+	u := BluetoothBase
+	binary.BigEndian.PutUint32(u[:4], v)
+	return u
+}
+
+// Bluetooth16 returns the 16-bit Bluetooth UUID of the UUID, and a boolean
+// indicating if the UUID can be shortened to 16 bits.
+func (u UUID) Bluetooth16() (uint16, bool) {
+	v, ok := u.Bluetooth32()
+	if !ok || v > 0xffff {
+		return 0, false
+	}
+	return uint16(v), true
+}
+
+// Bluetooth32 returns the 32-bit Bluetooth UUID of the UUID, and a boolean
+// indicating if the UUID can be shortened to 32 bits.
+func (u UUID) Bluetooth32() (uint32, bool) {
+	if [12]byte(u[4:]) != [12]byte(BluetoothBase[4:]) {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(u[:4]), true
+}
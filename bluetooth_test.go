@@ -0,0 +1,36 @@
+package uuid
+
+import "testing"
+
+func TestBluetooth16(t *testing.T) {
+	u := FromBluetooth16(0x180d)
+	if s := u.String(); s != "0000180d-0000-1000-8000-00805f9b34fb" {
+		t.Fatalf("Unexpected bluetooth UUID: %s", s)
+	}
+	v, ok := u.Bluetooth16()
+	if !ok || v != 0x180d {
+		t.Fatalf("Unexpected 16-bit bluetooth UUID: %x, %t", v, ok)
+	}
+
+	if _, ok = FromBluetooth32(0x1234180d).Bluetooth16(); ok {
+		t.Fatal("Should not be able to shorten a 32-bit bluetooth UUID to 16 bits")
+	}
+	if _, ok = newUUID().Bluetooth16(); ok {
+		t.Fatal("Should not be able to shorten a V4 UUID")
+	}
+}
+
+func TestBluetooth32(t *testing.T) {
+	u := FromBluetooth32(0x1234180d)
+	if s := u.String(); s != "1234180d-0000-1000-8000-00805f9b34fb" {
+		t.Fatalf("Unexpected bluetooth UUID: %s", s)
+	}
+	v, ok := u.Bluetooth32()
+	if !ok || v != 0x1234180d {
+		t.Fatalf("Unexpected 32-bit bluetooth UUID: %x, %t", v, ok)
+	}
+
+	if _, ok = newUUID().Bluetooth32(); ok {
+		t.Fatal("Should not be able to shorten a V4 UUID")
+	}
+}
@@ -0,0 +1,77 @@
+package uuid
+
+import "strings"
+
+const urnPrefix = "urn:uuid:"
+
+// Canonicalize parses the provided string leniently and returns it in the
+// canonical, lowercase 36-byte form.
+//
+// In addition to the canonical form, the following representations are
+// accepted, in either upper or lower case:
+//
+//	9E754EF6-8DD9-4903-AF43-7AEA99BFB1FE
+//	{9e754ef6-8dd9-4903-af43-7aea99bfb1fe}
+//	urn:uuid:9e754ef6-8dd9-4903-af43-7aea99bfb1fe
+//	9e754ef68dd94903af437aea99bfb1fe
+func Canonicalize(s string) (string, error) {
+	u, err := ParseLenient(s)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// ParseLenient parses the textual UUID s, accepting any of the representations
+// listed for Canonicalize. Unlike ParseString, the raw 16-byte form is not
+// accepted, and an empty string returns ErrInvalidUUID.
+func ParseLenient(s string) (UUID, error) {
+	return parseText(trimLenient(s))
+}
+
+// trimLenient removes a case-insensitive "urn:uuid:" prefix or a pair of
+// surrounding braces from s.
+func trimLenient(s string) string {
+	if len(s) > len(urnPrefix) && strings.EqualFold(s[:len(urnPrefix)], urnPrefix) {
+		return s[len(urnPrefix):]
+	}
+	if len(s) >= 2 && s[0] == '{' && s[len(s)-1] == '}' {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// MatchesString returns true if s is a textual representation of the UUID.
+// The comparison is case-insensitive and accepts the same forms as
+// Canonicalize, without formatting or parsing allocations.
+func (u UUID) MatchesString(s string) bool {
+	s = trimLenient(s)
+	switch len(s) {
+	case 32:
+		for i := 0; i < len(u); i++ {
+			if !matchesHexPair(u[i], s[i*2], s[i*2+1]) {
+				return false
+			}
+		}
+		return true
+	case 36:
+		if s[8] != dash || s[13] != dash || s[18] != dash || s[23] != dash {
+			return false
+		}
+		for i, off := range hexOffsets {
+			if !matchesHexPair(u[i], s[off], s[off+1]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// matchesHexPair returns true if the hexadecimal characters hi and lo encode
+// the byte b.
+func matchesHexPair(b, hi, lo byte) bool {
+	h, l := hexValues[hi], hexValues[lo]
+	return (h|l)&0xf0 == 0 && h<<4|l == b
+}
@@ -0,0 +1,86 @@
+package uuid
+
+import "testing"
+
+func TestCanonicalize(t *testing.T) {
+	const exp = "9e754ef6-8dd9-4903-af43-7aea99bfb1fe"
+	var table = []struct {
+		in    string
+		valid bool
+	}{
+		{"9e754ef6-8dd9-4903-af43-7aea99bfb1fe", true},
+		{"9E754EF6-8DD9-4903-AF43-7AEA99BFB1FE", true},
+		{"{9e754ef6-8dd9-4903-af43-7aea99bfb1fe}", true},
+		{"{9E754EF68DD94903AF437AEA99BFB1FE}", true},
+		{"urn:uuid:9e754ef6-8dd9-4903-af43-7aea99bfb1fe", true},
+		{"URN:UUID:9E754EF6-8DD9-4903-AF43-7AEA99BFB1FE", true},
+		{"9e754ef68dd94903af437aea99bfb1fe", true},
+		{"", false},
+		{"{}", false},
+		{"urn:uuid:", false},
+		{"0123456789abcdef", false},
+		{"{9e754ef6-8dd9-4903-af43-7aea99bfb1fe", false},
+		{"urn:uuid:{9e754ef6-8dd9-4903-af43-7aea99bfb1fe}", false},
+		{"9e754ef6-8dd9-4903-af43-7aea99bfb1fz", false},
+	}
+	for i := 0; i < len(table); i++ {
+		s, err := Canonicalize(table[i].in)
+		if !table[i].valid {
+			if err != ErrInvalidUUID {
+				t.Fatalf("Unexpected canonicalization pass: %s", table[i].in)
+			}
+			if _, err = ParseLenient(table[i].in); err != ErrInvalidUUID {
+				t.Fatalf("Unexpected lenient parse pass: %s", table[i].in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected canonicalization error for %s: %s", table[i].in, err.Error())
+		}
+		if s != exp {
+			t.Fatalf("Unexpected canonical string: %s", s)
+		}
+		if u, err := ParseLenient(table[i].in); err != nil || u.String() != exp {
+			t.Fatalf("Unexpected lenient parse of %s: %s, %v", table[i].in, u, err)
+		}
+	}
+}
+
+func TestMatchesString(t *testing.T) {
+	u := Must(ParseString("9e754ef6-8dd9-4903-af43-7aea99bfb1fe"))
+	var table = []struct {
+		in      string
+		matches bool
+	}{
+		{"9e754ef6-8dd9-4903-af43-7aea99bfb1fe", true},
+		{"9E754EF6-8DD9-4903-AF43-7AEA99BFB1FE", true},
+		{"{9e754ef6-8dd9-4903-af43-7aea99bfb1fe}", true},
+		{"urn:uuid:9e754ef6-8dd9-4903-af43-7aea99bfb1fe", true},
+		{"9e754ef68dd94903af437aea99bfb1fe", true},
+		{"9e754ef6-8dd9-4903-af43-7aea99bfb1ff", false},
+		{"9e754ef68dd94903af437aea99bfb1ff", false},
+		{"9e754ef6-8dd9-4903-af43-7aea99bfb1fz", false},
+		{"9e754ef6x8dd9-4903-af43-7aea99bfb1fe", false},
+		{"9e754ef6-8dd9-4903-af43-7aea99bfb1f", false},
+		{"", false},
+	}
+	for i := 0; i < len(table); i++ {
+		if m := u.MatchesString(table[i].in); m != table[i].matches {
+			t.Fatalf("Unexpected match result for %s: %t", table[i].in, m)
+		}
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		u.MatchesString("{9E754EF6-8DD9-4903-AF43-7AEA99BFB1FE}")
+	})
+	if allocs != 0 {
+		t.Fatalf("Unexpected allocations: %f", allocs)
+	}
+}
+
+func BenchmarkMatchesString(b *testing.B) {
+	u := Must(ParseString("9e754ef6-8dd9-4903-af43-7aea99bfb1fe"))
+	for i := 0; i < b.N; i++ {
+		u.MatchesString("9e754ef6-8dd9-4903-af43-7aea99bfb1fe")
+	}
+}
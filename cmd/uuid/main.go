@@ -0,0 +1,224 @@
+// Command uuid provides utilities for working with UUIDs.
+//
+// The normalize subcommand reads newline-delimited or CSV input, rewriting the
+// UUID in each line (or in a chosen CSV column) into a target encoding. Any
+// representation accepted by uuid.ParseLenient is understood as input. Rows
+// containing an invalid UUID are reported to stderr and removed by default:
+//
+//	uuid normalize -csv -col 2 -header < users.csv > users_clean.csv
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ryanfowler/uuid"
+)
+
+const usage = `usage: uuid <command> [flags]
+
+commands:
+  normalize  rewrite UUIDs in newline or CSV-delimited input
+`
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintf(os.Stderr, "uuid: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return errors.New("no command provided")
+	}
+	switch args[0] {
+	case "normalize":
+		return normalize(args[1:], stdin, stdout, stderr)
+	default:
+		fmt.Fprint(stderr, usage)
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
+
+// encoders holds the supported target encodings for the normalize command.
+var encoders = map[string]func(uuid.UUID) string{
+	"canonical": uuid.UUID.String,
+	"upper": func(u uuid.UUID) string {
+		return strings.ToUpper(u.String())
+	},
+	"hex": func(u uuid.UUID) string {
+		return strings.ReplaceAll(u.String(), "-", "")
+	},
+	"braced": func(u uuid.UUID) string {
+		return "{" + u.String() + "}"
+	},
+	"urn": func(u uuid.UUID) string {
+		return "urn:uuid:" + u.String()
+	},
+	"sortable": uuid.UUID.SortableString,
+}
+
+// normalizer rewrites UUID fields into the target encoding, reporting any
+// invalid fields.
+type normalizer struct {
+	encode  func(uuid.UUID) string
+	invalid string
+	stderr  io.Writer
+	bad     int
+}
+
+// field returns the normalized value of s and whether the containing row
+// should be written. It returns an error if the row is invalid and the
+// invalid mode is "fail".
+func (n *normalizer) field(line int, s string) (string, bool, error) {
+	if u, err := uuid.ParseLenient(strings.TrimSpace(s)); err == nil {
+		return n.encode(u), true, nil
+	}
+	ok, err := n.reject(line, fmt.Sprintf("invalid uuid %q", s))
+	return s, ok, err
+}
+
+// reject reports an invalid row, returning whether it should still be
+// written. It returns an error if the invalid mode is "fail".
+func (n *normalizer) reject(line int, reason string) (bool, error) {
+	n.bad++
+	switch n.invalid {
+	case "fail":
+		return false, fmt.Errorf("line %d: %s", line, reason)
+	case "keep":
+		fmt.Fprintf(n.stderr, "uuid normalize: line %d: %s (kept)\n", line, reason)
+		return true, nil
+	default:
+		fmt.Fprintf(n.stderr, "uuid normalize: line %d: %s (removed)\n", line, reason)
+		return false, nil
+	}
+}
+
+func normalize(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("normalize", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	isCSV := fs.Bool("csv", false, "parse input as CSV rather than one UUID per line")
+	col := fs.Int("col", 1, "1-based CSV column containing the UUID")
+	header := fs.Bool("header", false, "pass the first CSV row through unchanged")
+	to := fs.String("to", "canonical", "target encoding: canonical, upper, hex, braced, urn, or sortable")
+	invalid := fs.String("invalid", "drop", "handling of invalid rows: drop, keep, or fail")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	encode, ok := encoders[*to]
+	if !ok {
+		return fmt.Errorf("unknown target encoding %q", *to)
+	}
+	switch *invalid {
+	case "drop", "keep", "fail":
+	default:
+		return fmt.Errorf("unknown invalid row handling %q", *invalid)
+	}
+	if *col < 1 {
+		return fmt.Errorf("invalid column %d", *col)
+	}
+
+	in := stdin
+	if fs.NArg() > 0 {
+		readers := make([]io.Reader, 0, fs.NArg())
+		for _, name := range fs.Args() {
+			f, err := os.Open(name)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			readers = append(readers, f)
+		}
+		in = io.MultiReader(readers...)
+	}
+
+	n := &normalizer{encode: encode, invalid: *invalid, stderr: stderr}
+	var err error
+	if *isCSV {
+		err = n.csv(in, stdout, *col-1, *header)
+	} else {
+		err = n.lines(in, stdout)
+	}
+	if err != nil {
+		return err
+	}
+	if n.bad > 0 {
+		fmt.Fprintf(stderr, "uuid normalize: %d invalid row(s)\n", n.bad)
+	}
+	return nil
+}
+
+// lines normalizes one UUID per line from r, skipping blank lines.
+func (n *normalizer) lines(r io.Reader, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		if strings.TrimSpace(s.Text()) == "" {
+			continue
+		}
+		v, ok, err := n.field(line, s.Text())
+		if err != nil {
+			return err
+		}
+		if ok {
+			bw.WriteString(v)
+			bw.WriteByte('\n')
+		}
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// csv normalizes the UUID in column col of each CSV record from r.
+func (n *normalizer) csv(r io.Reader, w io.Writer, col int, header bool) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cw := csv.NewWriter(w)
+	for first := true; ; first = false {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		line, _ := cr.FieldPos(0)
+
+		if !(first && header) {
+			if col >= len(record) {
+				ok, err := n.reject(line, fmt.Sprintf("missing column %d", col+1))
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+			} else {
+				v, ok, err := n.field(line, record[col])
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+				record[col] = v
+			}
+		}
+		if err = cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
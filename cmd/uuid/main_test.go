@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeLines(t *testing.T) {
+	in := strings.Join([]string{
+		"9E754EF6-8DD9-4903-AF43-7AEA99BFB1FE",
+		"",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"not-a-uuid",
+		"urn:uuid:9e754ef68dd94903af437aea99bfb1fe",
+	}, "\n")
+
+	var stdout, stderr bytes.Buffer
+	err := run([]string{"normalize"}, strings.NewReader(in), &stdout, &stderr)
+	if err != nil {
+		t.Fatalf("Unexpected normalize error: %s", err.Error())
+	}
+	exp := "9e754ef6-8dd9-4903-af43-7aea99bfb1fe\n6ba7b810-9dad-11d1-80b4-00c04fd430c8\n9e754ef6-8dd9-4903-af43-7aea99bfb1fe\n"
+	if stdout.String() != exp {
+		t.Fatalf("Unexpected output:\n%s", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), `line 4: invalid uuid "not-a-uuid" (removed)`) {
+		t.Fatalf("Unexpected stderr:\n%s", stderr.String())
+	}
+}
+
+func TestNormalizeCSV(t *testing.T) {
+	in := "name,id\nalice,9E754EF6-8DD9-4903-AF43-7AEA99BFB1FE\nbob,invalid\n\"carol, jr\",{6ba7b810-9dad-11d1-80b4-00c04fd430c8}\n"
+
+	var stdout, stderr bytes.Buffer
+	args := []string{"normalize", "-csv", "-col", "2", "-header", "-to", "hex", "-invalid", "keep"}
+	err := run(args, strings.NewReader(in), &stdout, &stderr)
+	if err != nil {
+		t.Fatalf("Unexpected normalize error: %s", err.Error())
+	}
+	exp := "name,id\nalice,9e754ef68dd94903af437aea99bfb1fe\nbob,invalid\n\"carol, jr\",6ba7b8109dad11d180b400c04fd430c8\n"
+	if stdout.String() != exp {
+		t.Fatalf("Unexpected output:\n%s", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), `line 3: invalid uuid "invalid" (kept)`) {
+		t.Fatalf("Unexpected stderr:\n%s", stderr.String())
+	}
+}
+
+func TestNormalizeCSVMissingColumn(t *testing.T) {
+	in := "alice,9e754ef6-8dd9-4903-af43-7aea99bfb1fe\nbob\n"
+
+	var stdout, stderr bytes.Buffer
+	err := run([]string{"normalize", "-csv", "-col", "2"}, strings.NewReader(in), &stdout, &stderr)
+	if err != nil {
+		t.Fatalf("Unexpected normalize error: %s", err.Error())
+	}
+	if stdout.String() != "alice,9e754ef6-8dd9-4903-af43-7aea99bfb1fe\n" {
+		t.Fatalf("Unexpected output:\n%s", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "line 2: missing column 2 (removed)") {
+		t.Fatalf("Unexpected stderr:\n%s", stderr.String())
+	}
+
+	stdout.Reset()
+	stderr.Reset()
+	err = run([]string{"normalize", "-csv", "-col", "2", "-invalid", "keep"}, strings.NewReader(in), &stdout, &stderr)
+	if err != nil {
+		t.Fatalf("Unexpected normalize error: %s", err.Error())
+	}
+	if stdout.String() != in {
+		t.Fatalf("Unexpected output:\n%s", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "line 2: missing column 2 (kept)") {
+		t.Fatalf("Unexpected stderr:\n%s", stderr.String())
+	}
+}
+
+func TestNormalizeErrors(t *testing.T) {
+	var table = []struct {
+		args []string
+		in   string
+	}{
+		{[]string{}, ""},
+		{[]string{"unknown"}, ""},
+		{[]string{"normalize", "-to", "base64"}, ""},
+		{[]string{"normalize", "-invalid", "ignore"}, ""},
+		{[]string{"normalize", "-col", "0"}, ""},
+		{[]string{"normalize", "-invalid", "fail"}, "invalid\n"},
+		{[]string{"normalize", "-csv", "-col", "3", "-invalid", "fail"}, "a,b\n"},
+	}
+	for i := 0; i < len(table); i++ {
+		var stdout, stderr bytes.Buffer
+		err := run(table[i].args, strings.NewReader(table[i].in), &stdout, &stderr)
+		if err == nil {
+			t.Fatalf("Unexpected normalize pass: %v", table[i].args)
+		}
+	}
+}
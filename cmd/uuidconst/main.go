@@ -0,0 +1,144 @@
+// Command uuidconst generates Go source declaring well-known UUIDs as
+// [16]byte literals, validating them at generation time rather than parsing
+// them with MustParse-style calls during package initialization.
+//
+// UUIDs are provided as name=uuid pairs, either as arguments or as lines in an
+// input file (blank lines and lines starting with '#' are ignored):
+//
+//	//go:generate go run github.com/ryanfowler/uuid/cmd/uuidconst -pkg ids -o ids_gen.go NamespaceApp=9e754ef6-8dd9-4903-af43-7aea99bfb1fe
+//
+// As Go does not support array constants, each UUID is emitted as a package
+// level variable initialized with a composite literal, which requires no code
+// to be run at init.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ryanfowler/uuid"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "uuidconst: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("uuidconst", flag.ContinueOnError)
+	pkg := fs.String("pkg", os.Getenv("GOPACKAGE"), "package name of the generated file")
+	out := fs.String("o", "", "output file (default stdout)")
+	in := fs.String("i", "", "input file containing name=uuid lines")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *pkg == "" {
+		return errors.New("package name must be provided with -pkg")
+	}
+
+	pairs := fs.Args()
+	if *in != "" {
+		lines, err := readLines(*in)
+		if err != nil {
+			return err
+		}
+		pairs = append(lines, pairs...)
+	}
+
+	src, err := generate(*pkg, pairs)
+	if err != nil {
+		return err
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(*out, src, 0o644)
+}
+
+// readLines returns the non-empty, non-comment lines of the named file.
+func readLines(name string) ([]string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, s.Err()
+}
+
+// generate returns the formatted Go source declaring the provided name=uuid
+// pairs in the package pkg.
+func generate(pkg string, pairs []string) ([]byte, error) {
+	if !token.IsIdentifier(pkg) {
+		return nil, fmt.Errorf("invalid package name %q", pkg)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by uuidconst. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	fmt.Fprintf(&buf, "import \"github.com/ryanfowler/uuid\"\n\n")
+	fmt.Fprintf(&buf, "var (\n")
+
+	seen := make(map[string]struct{}, len(pairs))
+	for _, pair := range pairs {
+		name, value, ok := strings.Cut(pair, "=")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !ok || !token.IsIdentifier(name) {
+			return nil, fmt.Errorf("invalid name=uuid pair %q", pair)
+		}
+		if _, ok := seen[name]; ok {
+			return nil, fmt.Errorf("duplicate name %q", name)
+		}
+		seen[name] = struct{}{}
+
+		u, err := parseText(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid uuid for %s: %q", name, value)
+		}
+		writeVar(&buf, name, u)
+	}
+	fmt.Fprintf(&buf, ")\n")
+
+	return format.Source(buf.Bytes())
+}
+
+// parseText parses the 32 or 36-byte textual UUID s, rejecting the raw
+// 16-byte form accepted by uuid.ParseString.
+func parseText(s string) (uuid.UUID, error) {
+	if len(s) != 32 && len(s) != 36 {
+		return uuid.UUID{}, uuid.ErrInvalidUUID
+	}
+	return uuid.ParseString(s)
+}
+
+func writeVar(w io.Writer, name string, u uuid.UUID) {
+	fmt.Fprintf(w, "\t// %s is the UUID %s.\n", name, u.String())
+	fmt.Fprintf(w, "\t%s = uuid.UUID{", name)
+	for i, b := range u {
+		if i > 0 {
+			fmt.Fprint(w, ", ")
+		}
+		fmt.Fprintf(w, "%#02x", b)
+	}
+	fmt.Fprintf(w, "}\n")
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	src, err := generate("ids", []string{
+		"NamespaceDNS=6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"namespaceApp = 9E754EF68DD94903AF437AEA99BFB1FE",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected generate error: %s", err.Error())
+	}
+
+	exp := `// Code generated by uuidconst. DO NOT EDIT.
+
+package ids
+
+import "github.com/ryanfowler/uuid"
+
+var (
+	// NamespaceDNS is the UUID 6ba7b810-9dad-11d1-80b4-00c04fd430c8.
+	NamespaceDNS = uuid.UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	// namespaceApp is the UUID 9e754ef6-8dd9-4903-af43-7aea99bfb1fe.
+	namespaceApp = uuid.UUID{0x9e, 0x75, 0x4e, 0xf6, 0x8d, 0xd9, 0x49, 0x03, 0xaf, 0x43, 0x7a, 0xea, 0x99, 0xbf, 0xb1, 0xfe}
+)
+`
+	if string(src) != exp {
+		t.Fatalf("Unexpected generated source:\n%s", src)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	var table = []struct {
+		name  string
+		pkg   string
+		pairs []string
+		err   string
+	}{
+		{
+			name:  "invalid package",
+			pkg:   "my-pkg",
+			pairs: nil,
+			err:   `invalid package name "my-pkg"`,
+		},
+		{
+			name:  "missing separator",
+			pkg:   "ids",
+			pairs: []string{"Foo"},
+			err:   `invalid name=uuid pair "Foo"`,
+		},
+		{
+			name:  "invalid identifier",
+			pkg:   "ids",
+			pairs: []string{"1Foo=6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+			err:   `invalid name=uuid pair "1Foo=6ba7b810-9dad-11d1-80b4-00c04fd430c8"`,
+		},
+		{
+			name: "duplicate name",
+			pkg:  "ids",
+			pairs: []string{
+				"Foo=6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+				"Foo=9e754ef6-8dd9-4903-af43-7aea99bfb1fe",
+			},
+			err: `duplicate name "Foo"`,
+		},
+		{
+			name:  "invalid uuid",
+			pkg:   "ids",
+			pairs: []string{"Foo=6ba7b810-9dad-11d1-80b4"},
+			err:   `invalid uuid for Foo: "6ba7b810-9dad-11d1-80b4"`,
+		},
+		{
+			name:  "binary uuid",
+			pkg:   "ids",
+			pairs: []string{"Foo=abcdefghijklmnop"},
+			err:   `invalid uuid for Foo: "abcdefghijklmnop"`,
+		},
+	}
+
+	for i := 0; i < len(table); i++ {
+		ts := table[i]
+		t.Run(ts.name, func(t *testing.T) {
+			_, err := generate(ts.pkg, ts.pairs)
+			if err == nil || !strings.Contains(err.Error(), ts.err) {
+				t.Fatalf("Unexpected generate error: %v", err)
+			}
+		})
+	}
+}
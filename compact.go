@@ -0,0 +1,40 @@
+package uuid
+
+import "encoding/base64"
+
+// compactLen is the length of a base64 encoded UUID, including padding.
+const compactLen = 24
+
+// CompactUUID is a UUID that is encoded in JSON as a 24 byte string of its 16
+// raw bytes using standard, padded base64, instead of the 36 byte formatted
+// string. When unmarshaling, both the base64 and formatted strings are
+// accepted, allowing for a gradual migration between the two. All other
+// encodings, including database values, are the same as for UUID.
+//
+// Example: "nnVO9o3ZSQOvQ3rqmb+x/g=="
+type CompactUUID UUID
+
+// MarshalJSON implements the json Marshaler interface. It returns the JSON
+// string of the base64 encoded UUID.
+func (c CompactUUID) MarshalJSON() ([]byte, error) {
+	var b [compactLen + 2]byte
+	b[0] = '"'
+	base64.StdEncoding.Encode(b[1:], c[:])
+	b[compactLen+1] = '"'
+	return b[:], nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface. It reads either a
+// base64 encoded or 36 byte formatted JSON string UUID from b into c.
+func (c *CompactUUID) UnmarshalJSON(b []byte) error {
+	if len(b) != compactLen+2 || b[0] != '"' || b[compactLen+1] != '"' {
+		return (*UUID)(c).UnmarshalJSON(b)
+	}
+	var buf [18]byte
+	n, err := base64.StdEncoding.Decode(buf[:], b[1:compactLen+1])
+	if err != nil || n != len(c) {
+		return ErrInvalidUUID
+	}
+	copy(c[:], buf[:n])
+	return nil
+}
@@ -0,0 +1,34 @@
+package uuid
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCompactUUID(t *testing.T) {
+	u := Must(ParseString("9e754ef6-8dd9-4903-af43-7aea99bfb1fe"))
+	b, err := json.Marshal(CompactUUID(u))
+	if err != nil {
+		t.Fatalf("Unexpected json marshaling error: %s", err.Error())
+	}
+	if string(b) != `"nnVO9o3ZSQOvQ3rqmb+x/g=="` {
+		t.Fatalf("Unexpected json marshaling result: %s", b)
+	}
+
+	for _, s := range []string{`"nnVO9o3ZSQOvQ3rqmb+x/g=="`, `"9e754ef6-8dd9-4903-af43-7aea99bfb1fe"`} {
+		var c CompactUUID
+		if err = json.Unmarshal([]byte(s), &c); err != nil {
+			t.Fatalf("Unexpected json unmarshaling error: %s", err.Error())
+		}
+		if UUID(c) != u {
+			t.Fatalf("Unexpected json unmarshaling result: %s", c)
+		}
+	}
+
+	for _, s := range []string{`"nnVO9o3ZSQOvQ3rqmb+x/g="`, `"nnVO9o3ZSQOvQ3rqmb+x/g.="`, `"nnVO9o3ZSQOvQ3rqmb+x/gAA"`, `"bad"`} {
+		var c CompactUUID
+		if err = c.UnmarshalJSON([]byte(s)); err != ErrInvalidUUID {
+			t.Fatalf("Unexpected json unmarshaling pass: %s", s)
+		}
+	}
+}
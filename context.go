@@ -0,0 +1,48 @@
+package uuid
+
+import (
+	"context"
+	"time"
+)
+
+// NewV4Context generates and returns a new v4 UUID as per NewV4, returning
+// early with the context's error if ctx is cancelled or its deadline passes
+// before random bytes can be read.
+func NewV4Context(ctx context.Context) (UUID, error) {
+	return withContext(ctx, NewV4)
+}
+
+// NewV7Context uses the provided timestamp to generate and return a new V7
+// UUID as per NewV7, returning early with the context's error if ctx is
+// cancelled or its deadline passes before random bytes can be read.
+func NewV7Context(ctx context.Context, now time.Time) (UUID, error) {
+	return withContext(ctx, func() (UUID, error) { return NewV7(now) })
+}
+
+// withContext calls gen, returning early if ctx is done before gen returns.
+// As reads of random bytes cannot be interrupted, gen continues to run in the
+// background and its result is discarded.
+func withContext(ctx context.Context, gen func() (UUID, error)) (UUID, error) {
+	if err := ctx.Err(); err != nil {
+		return UUID{}, err
+	}
+	if ctx.Done() == nil {
+		return gen()
+	}
+
+	type result struct {
+		u   UUID
+		err error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		u, err := gen()
+		ch <- result{u, err}
+	}()
+	select {
+	case res := <-ch:
+		return res.u, res.err
+	case <-ctx.Done():
+		return UUID{}, ctx.Err()
+	}
+}
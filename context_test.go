@@ -0,0 +1,54 @@
+package uuid
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// blockingReader is an io.Reader that blocks until its channel is closed.
+type blockingReader chan struct{}
+
+func (r blockingReader) Read(p []byte) (int, error) {
+	<-r
+	return len(p), nil
+}
+
+func TestNewV4Context(t *testing.T) {
+	u, err := NewV4Context(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error generating uuid v4: %s", err.Error())
+	}
+	verifyVersion(t, u, 4)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = NewV4Context(ctx); err != context.Canceled {
+		t.Fatalf("Unexpected error with cancelled context: %v", err)
+	}
+}
+
+func TestNewV7Context(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	u, err := NewV7Context(ctx, now)
+	if err != nil {
+		t.Fatalf("Unexpected error generating uuid v7: %s", err.Error())
+	}
+	if ut, _ := u.Time(); !ut.Equal(now) {
+		t.Fatalf("Time not equal to original: %v vs %v", now, ut)
+	}
+}
+
+func TestWithContextBlocked(t *testing.T) {
+	r := make(blockingReader)
+	defer close(r)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := withContext(ctx, func() (UUID, error) { return NewV4FromRand(r) })
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Unexpected error with blocked reader: %v", err)
+	}
+}
@@ -0,0 +1,52 @@
+package uuid
+
+// MarshalCSV returns the 36 byte hexadecimal representation of the UUID for
+// use in a CSV cell, as used by libraries such as github.com/gocarina/gocsv.
+// The zero UUID is written as an empty cell.
+//
+// Use RequiredUUID to write the zero UUID as "00000000-0000-0000-0000-000000000000"
+// instead.
+func (u UUID) MarshalCSV() (string, error) {
+	if u.IsZero() {
+		return "", nil
+	}
+	return u.String(), nil
+}
+
+// UnmarshalCSV reads the UUID from the CSV cell s into u, accepting the same
+// representations as ParseLenient. An empty cell is read as the zero UUID.
+//
+// Use RequiredUUID to reject empty cells instead.
+func (u *UUID) UnmarshalCSV(s string) error {
+	if s == "" {
+		*u = UUID{}
+		return nil
+	}
+	id, err := ParseLenient(s)
+	if err != nil {
+		return err
+	}
+	*u = id
+	return nil
+}
+
+// RequiredUUID is a UUID that must be present in a CSV cell. Unlike UUID, the
+// zero UUID is written in its hexadecimal form rather than as an empty cell,
+// and reading an empty cell returns ErrInvalidUUID.
+type RequiredUUID UUID
+
+// MarshalCSV returns the 36 byte hexadecimal representation of the UUID.
+func (r RequiredUUID) MarshalCSV() (string, error) {
+	return UUID(r).String(), nil
+}
+
+// UnmarshalCSV reads the UUID from the CSV cell s into r, returning
+// ErrInvalidUUID if the cell is empty.
+func (r *RequiredUUID) UnmarshalCSV(s string) error {
+	u, err := ParseLenient(s)
+	if err != nil {
+		return err
+	}
+	*r = RequiredUUID(u)
+	return nil
+}
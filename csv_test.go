@@ -0,0 +1,70 @@
+package uuid
+
+import "testing"
+
+type csvMarshaler interface {
+	MarshalCSV() (string, error)
+}
+
+type csvUnmarshaler interface {
+	UnmarshalCSV(string) error
+}
+
+var (
+	_ csvMarshaler = UUID{}
+	_ csvMarshaler = RequiredUUID{}
+	_ csvMarshaler = ID[testUser]{}
+
+	_ csvUnmarshaler = (*UUID)(nil)
+	_ csvUnmarshaler = (*RequiredUUID)(nil)
+	_ csvUnmarshaler = (*ID[testUser])(nil)
+)
+
+func TestCSV(t *testing.T) {
+	u1 := newUUID()
+	s, err := u1.MarshalCSV()
+	if err != nil {
+		t.Fatalf("Unexpected csv marshaling error: %s", err.Error())
+	}
+	if s != u1.String() {
+		t.Fatalf("Unexpected csv marshaling result: %s", s)
+	}
+	var u2 UUID
+	if err = u2.UnmarshalCSV(s); err != nil {
+		t.Fatalf("Unexpected csv unmarshaling error: %s", err.Error())
+	}
+	if u2 != u1 {
+		t.Fatalf("Unexpected csv unmarshaling result: %s", u2)
+	}
+
+	if s, _ = (UUID{}).MarshalCSV(); s != "" {
+		t.Fatalf("Unexpected csv marshaling result for zero UUID: %s", s)
+	}
+	if err = u2.UnmarshalCSV(""); err != nil {
+		t.Fatalf("Unexpected csv unmarshaling error: %s", err.Error())
+	}
+	if !u2.IsZero() {
+		t.Fatalf("Expected UUID to be zero from empty cell, got: %s", u2)
+	}
+	if err = u2.UnmarshalCSV("invalid"); err != ErrInvalidUUID {
+		t.Fatalf("Unexpected csv unmarshaling error: %v", err)
+	}
+}
+
+func TestRequiredUUIDCSV(t *testing.T) {
+	if s, _ := (RequiredUUID{}).MarshalCSV(); s != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Unexpected csv marshaling result for zero UUID: %s", s)
+	}
+
+	u := newUUID()
+	var r RequiredUUID
+	if err := r.UnmarshalCSV(u.String()); err != nil {
+		t.Fatalf("Unexpected csv unmarshaling error: %s", err.Error())
+	}
+	if UUID(r) != u || r.String() != u.String() {
+		t.Fatalf("Unexpected csv unmarshaling result: %s", r)
+	}
+	if err := r.UnmarshalCSV(""); err != ErrInvalidUUID {
+		t.Fatalf("Unexpected csv unmarshaling error for empty cell: %v", err)
+	}
+}
@@ -0,0 +1,138 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// debugField describes a field of a UUID layout: its name and bit width.
+type debugField struct {
+	name string
+	bits int
+}
+
+// gregorianOffset is the number of 100-nanosecond intervals between the
+// Gregorian epoch (1582-10-15) used by V1 and V6 UUIDs and the Unix epoch.
+const gregorianOffset = 122192928000000000
+
+var (
+	layoutV1  = []debugField{{"time_low", 32}, {"time_mid", 16}, {"ver", 4}, {"time_high", 12}, {"var", 2}, {"clock_seq", 14}, {"node", 48}}
+	layoutV3  = []debugField{{"md5_high", 48}, {"ver", 4}, {"md5_mid", 12}, {"var", 2}, {"md5_low", 62}}
+	layoutV4  = []debugField{{"random_a", 48}, {"ver", 4}, {"random_b", 12}, {"var", 2}, {"random_c", 62}}
+	layoutV5  = []debugField{{"sha1_high", 48}, {"ver", 4}, {"sha1_mid", 12}, {"var", 2}, {"sha1_low", 62}}
+	layoutV6  = []debugField{{"time_high", 32}, {"time_mid", 16}, {"ver", 4}, {"time_low", 12}, {"var", 2}, {"clock_seq", 14}, {"node", 48}}
+	layoutV7  = []debugField{{"unix_ts_ms", 48}, {"ver", 4}, {"rand_a", 12}, {"var", 2}, {"rand_b", 62}}
+	layoutV8  = []debugField{{"custom_a", 48}, {"ver", 4}, {"custom_b", 12}, {"var", 2}, {"custom_c", 62}}
+	layoutRaw = []debugField{{"data_high", 64}, {"data_low", 64}}
+)
+
+// DebugString returns a multi-line, annotated representation of the UUID for
+// debugging purposes. The first line contains the formatted UUID along with
+// its detected version, variant, and embedded timestamp (if any). Each
+// following line contains a field of the layout for the detected version,
+// with its bit width, hexadecimal value, and binary value.
+//
+// The format of the returned string is not stable and should not be parsed.
+//
+// Example:
+//
+//	018f3a2b-1c2d-73a2-8a5b-6c7d8e9fa0b1 version=7 variant=RFC9562 time=2024-05-02T16:37:34.893Z
+//	  unix_ts_ms  48  0x018f3a2b1c2d  000000011000111100111010001010110001110000101101
+//	  ver          4  0x7  0111
+//	  rand_a      12  0x3a2  001110100010
+//	  var          2  0x2  10
+//	  rand_b      62  0x0a5b6c7d8e9fa0b1  00101001011011011011000111110110001110100111111010000010110001
+func (u UUID) DebugString() string {
+	var sb strings.Builder
+	variant := u.variantName()
+	fmt.Fprintf(&sb, "%s version=%d variant=%s", u.String(), u.Version(), variant)
+
+	layout := layoutRaw
+	if variant == "RFC9562" {
+		switch u.Version() {
+		case 1, 2:
+			layout = layoutV1
+		case 3:
+			layout = layoutV3
+		case 4:
+			layout = layoutV4
+		case 5:
+			layout = layoutV5
+		case 6:
+			layout = layoutV6
+		case 7:
+			layout = layoutV7
+		case 8:
+			layout = layoutV8
+		}
+		if t, ok := u.debugTime(); ok {
+			fmt.Fprintf(&sb, " time=%s", t.UTC().Format(time.RFC3339Nano))
+		}
+	}
+	switch u {
+	case UUID{}:
+		sb.WriteString(" (nil)")
+	case UUID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}:
+		sb.WriteString(" (max)")
+	}
+
+	var off int
+	for _, f := range layout {
+		v := u.bitsAt(off, f.bits)
+		fmt.Fprintf(&sb, "\n  %-10s  %2d  0x%0*x  %0*b", f.name, f.bits, (f.bits+3)/4, v, f.bits, v)
+		off += f.bits
+	}
+	return sb.String()
+}
+
+// variantName returns the name of the variant of the UUID.
+func (u UUID) variantName() string {
+	switch {
+	case u[8]&0x80 == 0x00:
+		return "NCS"
+	case u[8]&0xc0 == 0x80:
+		return "RFC9562"
+	case u[8]&0xe0 == 0xc0:
+		return "Microsoft"
+	default:
+		return "Future"
+	}
+}
+
+// debugTime returns the embedded timestamp of V1, V6, and V7 UUIDs.
+func (u UUID) debugTime() (time.Time, bool) {
+	var ts uint64
+	switch u.Version() {
+	case 1:
+		ts = u.bitsAt(52, 12)<<48 | u.bitsAt(32, 16)<<32 | u.bitsAt(0, 32)
+	case 6:
+		ts = u.bitsAt(0, 48)<<12 | u.bitsAt(52, 12)
+	case 7:
+		return u.Time()
+	default:
+		return time.Time{}, false
+	}
+	// Split into seconds and nanoseconds to avoid overflowing time.Duration.
+	d := int64(ts) - gregorianOffset
+	return time.Unix(d/1e7, d%1e7*100), true
+}
+
+// bitsAt returns n bits (at most 64) of the UUID starting at the provided bit
+// offset.
+func (u UUID) bitsAt(off, n int) uint64 {
+	hi := binary.BigEndian.Uint64(u[:8])
+	lo := binary.BigEndian.Uint64(u[8:])
+	// Shift the 128-bit value left by off, keeping the top 64 bits.
+	var top uint64
+	switch {
+	case off == 0:
+		top = hi
+	case off < 64:
+		top = hi<<off | lo>>(64-off)
+	default:
+		top = lo << (off - 64)
+	}
+	return top >> (64 - n)
+}
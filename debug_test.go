@@ -0,0 +1,77 @@
+package uuid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDebugString(t *testing.T) {
+	u := Must(ParseString("018f3a2b-1c2d-73a2-8a5b-6c7d8e9fa0b1"))
+	exp := `018f3a2b-1c2d-73a2-8a5b-6c7d8e9fa0b1 version=7 variant=RFC9562 time=2024-05-02T16:37:34.893Z
+  unix_ts_ms  48  0x018f3a2b1c2d  000000011000111100111010001010110001110000101101
+  ver          4  0x7  0111
+  rand_a      12  0x3a2  001110100010
+  var          2  0x2  10
+  rand_b      62  0x0a5b6c7d8e9fa0b1  00101001011011011011000111110110001110100111111010000010110001`
+	if s := u.DebugString(); s != exp {
+		t.Fatalf("Unexpected debug string:\n%s", s)
+	}
+}
+
+func TestDebugStringVersions(t *testing.T) {
+	var table = []struct {
+		name   string
+		u      string
+		header string
+		fields []string
+	}{
+		{
+			name:   "v1",
+			u:      "c232ab00-9414-11ec-b3c8-9f6bdeced846",
+			header: "c232ab00-9414-11ec-b3c8-9f6bdeced846 version=1 variant=RFC9562 time=2022-02-22T19:22:22Z",
+			fields: []string{"time_low", "time_mid", "ver", "time_high", "var", "clock_seq", "node"},
+		},
+		{
+			name:   "v6",
+			u:      "1ec9414c-232a-6b00-b3c8-9f6bdeced846",
+			header: "1ec9414c-232a-6b00-b3c8-9f6bdeced846 version=6 variant=RFC9562 time=2022-02-22T19:22:22Z",
+			fields: []string{"time_high", "time_mid", "ver", "time_low", "var", "clock_seq", "node"},
+		},
+		{
+			name:   "v5",
+			u:      "2ed6657d-e927-568b-95e1-2665a8aea6a2",
+			header: "2ed6657d-e927-568b-95e1-2665a8aea6a2 version=5 variant=RFC9562",
+			fields: []string{"sha1_high", "ver", "sha1_mid", "var", "sha1_low"},
+		},
+		{
+			name:   "nil",
+			u:      "00000000-0000-0000-0000-000000000000",
+			header: "00000000-0000-0000-0000-000000000000 version=0 variant=NCS (nil)",
+			fields: []string{"data_high", "data_low"},
+		},
+		{
+			name:   "microsoft",
+			u:      "00000000-0000-4000-c000-000000000000",
+			header: "00000000-0000-4000-c000-000000000000 version=4 variant=Microsoft",
+			fields: []string{"data_high", "data_low"},
+		},
+	}
+
+	for i := 0; i < len(table); i++ {
+		ts := table[i]
+		t.Run(ts.name, func(t *testing.T) {
+			lines := strings.Split(Must(ParseString(ts.u)).DebugString(), "\n")
+			if lines[0] != ts.header {
+				t.Fatalf("Unexpected header: %s", lines[0])
+			}
+			if len(lines) != len(ts.fields)+1 {
+				t.Fatalf("Unexpected number of fields: %d", len(lines)-1)
+			}
+			for j, f := range ts.fields {
+				if name := strings.Fields(lines[j+1])[0]; name != f {
+					t.Fatalf("Unexpected field %d: %s", j, name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package uuid
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// maxDedupAttempts is the number of times a DedupGenerator attempts to
+// generate a UUID that is not a duplicate before giving up.
+const maxDedupAttempts = 8
+
+// ErrDuplicate represents the error returned by a DedupGenerator when its
+// underlying Generator repeatedly returns duplicate UUIDs.
+var ErrDuplicate = errors.New("uuid: duplicate uuid generated")
+
+// DedupGenerator wraps a Generator, detecting duplicate UUIDs within a
+// bounded window of the most recently generated UUIDs. When a duplicate is
+// detected, it is counted, reported to the optional hook, and a new UUID is
+// generated in its place. If the underlying Generator returns duplicates
+// repeatedly, ErrDuplicate is returned.
+//
+// A DedupGenerator is safe for concurrent use.
+type DedupGenerator struct {
+	g     Generator
+	onDup func(UUID)
+	dups  atomic.Uint64
+
+	mu   sync.Mutex
+	seen map[UUID]struct{}
+	ring []UUID
+	next int
+}
+
+// NewDedupGenerator returns a new DedupGenerator wrapping g, which remembers
+// the last window UUIDs generated. If onDuplicate is not nil, it is called
+// with each duplicate UUID detected. A window less than 1 is treated as 1.
+func NewDedupGenerator(g Generator, window int, onDuplicate func(UUID)) *DedupGenerator {
+	if window < 1 {
+		window = 1
+	}
+	return &DedupGenerator{
+		g:     g,
+		onDup: onDuplicate,
+		seen:  make(map[UUID]struct{}, window),
+		ring:  make([]UUID, 0, window),
+	}
+}
+
+// NewV4 generates and returns a new v4 UUID using the underlying Generator,
+// ensuring it is not a duplicate of a UUID within the window.
+func (d *DedupGenerator) NewV4() (UUID, error) {
+	return d.generate(d.g.NewV4)
+}
+
+// NewV7 generates and returns a new V7 UUID using the underlying Generator,
+// ensuring it is not a duplicate of a UUID within the window.
+func (d *DedupGenerator) NewV7(now time.Time) (UUID, error) {
+	return d.generate(func() (UUID, error) { return d.g.NewV7(now) })
+}
+
+// Duplicates returns the number of duplicate UUIDs detected.
+func (d *DedupGenerator) Duplicates() uint64 {
+	return d.dups.Load()
+}
+
+// DedupState is a snapshot of the state of a DedupGenerator.
+type DedupState struct {
+	// Window is the maximum number of UUIDs remembered.
+	Window int
+	// Len is the number of UUIDs currently remembered.
+	Len int
+	// Duplicates is the number of duplicate UUIDs detected.
+	Duplicates uint64
+}
+
+// State returns a snapshot of the current state of the generator.
+func (d *DedupGenerator) State() DedupState {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return DedupState{
+		Window:     cap(d.ring),
+		Len:        len(d.ring),
+		Duplicates: d.dups.Load(),
+	}
+}
+
+func (d *DedupGenerator) generate(gen func() (UUID, error)) (UUID, error) {
+	for i := 0; i < maxDedupAttempts; i++ {
+		u, err := gen()
+		if err != nil {
+			return UUID{}, err
+		}
+		if d.add(u) {
+			return u, nil
+		}
+		d.dups.Add(1)
+		if d.onDup != nil {
+			d.onDup(u)
+		}
+	}
+	return UUID{}, ErrDuplicate
+}
+
+// add adds u to the window, evicting the oldest UUID if the window is full.
+// It returns false if u is already in the window.
+func (d *DedupGenerator) add(u UUID) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.seen[u]; ok {
+		return false
+	}
+	if len(d.ring) < cap(d.ring) {
+		d.ring = append(d.ring, u)
+	} else {
+		delete(d.seen, d.ring[d.next])
+		d.ring[d.next] = u
+		d.next = (d.next + 1) % len(d.ring)
+	}
+	d.seen[u] = struct{}{}
+	return true
+}
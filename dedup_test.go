@@ -0,0 +1,82 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+var _ Generator = (*DedupGenerator)(nil)
+
+// repeatReader is an io.Reader that returns the same bytes on every call to
+// Read, causing every generated UUID to be identical.
+type repeatReader struct{}
+
+func (repeatReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = byte(i)
+	}
+	return len(p), nil
+}
+
+func TestDedupGenerator(t *testing.T) {
+	var hooked []UUID
+	g := NewDedupGenerator(Default(), 16, func(u UUID) { hooked = append(hooked, u) })
+	for i := 0; i < 100; i++ {
+		verifyVersion(t, Must(g.NewV4()), 4)
+		verifyVersion(t, Must(g.NewV7(time.Now())), 7)
+	}
+	if g.Duplicates() != 0 || len(hooked) != 0 {
+		t.Fatalf("Unexpected duplicates: %d", g.Duplicates())
+	}
+	if len(g.ring) != 16 || len(g.seen) != 16 {
+		t.Fatalf("Unexpected window size: %d, %d", len(g.ring), len(g.seen))
+	}
+}
+
+func TestDedupGeneratorDuplicates(t *testing.T) {
+	var hooked []UUID
+	g := NewDedupGenerator(NewGenerator(repeatReader{}), 4, func(u UUID) { hooked = append(hooked, u) })
+
+	u1, err := g.NewV4()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if _, err = g.NewV4(); err != ErrDuplicate {
+		t.Fatalf("Unexpected error for duplicate: %v", err)
+	}
+	if g.Duplicates() != maxDedupAttempts || len(hooked) != maxDedupAttempts {
+		t.Fatalf("Unexpected number of duplicates: %d, %d", g.Duplicates(), len(hooked))
+	}
+	if !bytes.Equal(hooked[0][:], u1[:]) {
+		t.Fatalf("Unexpected duplicate reported: %s", hooked[0])
+	}
+}
+
+func TestDedupGeneratorWindow(t *testing.T) {
+	g := NewDedupGenerator(NewGenerator(repeatReader{}), 2, nil)
+	now := time.Now()
+	_ = Must(g.NewV4())
+	_ = Must(g.NewV7(now))
+	_ = Must(g.NewV7(now.Add(time.Millisecond)))
+	// The V4 UUID has been evicted from the window, so is no longer a
+	// duplicate.
+	if _, err := g.NewV4(); err != nil {
+		t.Fatalf("Unexpected error after eviction: %s", err.Error())
+	}
+	if g.Duplicates() != 0 {
+		t.Fatalf("Unexpected duplicates: %d", g.Duplicates())
+	}
+}
+
+func TestDedupGeneratorState(t *testing.T) {
+	g := NewDedupGenerator(NewGenerator(repeatReader{}), 4, nil)
+	if s := g.State(); s != (DedupState{Window: 4}) {
+		t.Fatalf("Unexpected initial state: %+v", s)
+	}
+	_ = Must(g.NewV4())
+	_, _ = g.NewV4()
+	if s := g.State(); s != (DedupState{Window: 4, Len: 1, Duplicates: maxDedupAttempts}) {
+		t.Fatalf("Unexpected state: %+v", s)
+	}
+}
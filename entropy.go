@@ -0,0 +1,11 @@
+//go:build !tinygo
+
+package uuid
+
+import (
+	"crypto/rand"
+	"io"
+)
+
+// entropy is the source of random bytes for the default Generator.
+var entropy io.Reader = rand.Reader
@@ -0,0 +1,47 @@
+//go:build tinygo
+
+package uuid
+
+import (
+	"errors"
+	"io"
+	"sync/atomic"
+)
+
+// entropy is the source of random bytes for the default Generator. On TinyGo
+// targets, it reads from the function installed with SetRandFunc.
+var entropy io.Reader = funcReader{}
+
+var randFunc atomic.Pointer[func(b []byte) error]
+
+// ErrNoRandFunc is returned when generating a random UUID on TinyGo before a
+// function has been installed with SetRandFunc.
+var ErrNoRandFunc = errors.New("uuid: no random function set")
+
+// SetRandFunc sets the function used by the default Generator to fill b with
+// random bytes, allowing embedded targets where "crypto/rand" is unavailable
+// or slow to supply a hardware RNG. Until a function is installed, or after
+// fn is set to nil, random UUIDs cannot be generated and ErrNoRandFunc is
+// returned. It is safe to call concurrently with generating UUIDs.
+//
+// SetRandFunc is only available when building with TinyGo.
+func SetRandFunc(fn func(b []byte) error) {
+	if fn == nil {
+		randFunc.Store(nil)
+		return
+	}
+	randFunc.Store(&fn)
+}
+
+type funcReader struct{}
+
+func (funcReader) Read(b []byte) (int, error) {
+	fn := randFunc.Load()
+	if fn == nil {
+		return 0, ErrNoRandFunc
+	}
+	if err := (*fn)(b); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
@@ -0,0 +1,38 @@
+//go:build tinygo
+
+package uuid
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSetRandFunc(t *testing.T) {
+	defer SetRandFunc(nil)
+
+	SetRandFunc(func(b []byte) error {
+		for i := range b {
+			b[i] = 0xff
+		}
+		return nil
+	})
+	u, err := NewV4()
+	if err != nil {
+		t.Fatalf("Unexpected V4 error: %s", err.Error())
+	}
+	if s := u.String(); s != "ffffffff-ffff-4fff-bfff-ffffffffffff" {
+		t.Fatalf("Unexpected V4 UUID: %s", s)
+	}
+
+	errRNG := errors.New("rng failure")
+	SetRandFunc(func([]byte) error { return errRNG })
+	if _, err = NewV7(time.Now()); err != errRNG {
+		t.Fatalf("Unexpected V7 error: %v", err)
+	}
+
+	SetRandFunc(nil)
+	if _, err = NewV4(); err != ErrNoRandFunc {
+		t.Fatalf("Unexpected V4 error: %v", err)
+	}
+}
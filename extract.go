@@ -0,0 +1,92 @@
+package uuid
+
+// Extract returns all canonical, 36-byte UUIDs found in s, in the order they
+// appear. See Extractor for more information.
+func Extract(s string) []UUID {
+	return Extractor{}.Extract(s)
+}
+
+// FindAllIndex returns the start and end indexes of up to n canonical, 36-byte
+// UUIDs found in s, in the same form as regexp.Regexp.FindAllStringIndex. If n
+// is negative, all UUIDs are returned. See Extractor for more information.
+func FindAllIndex(s string, n int) [][]int {
+	return Extractor{}.FindAllIndex(s, n)
+}
+
+// Extractor locates UUIDs embedded in arbitrary text without using regular
+// expressions. The zero value matches canonical, 36-byte UUIDs in either upper
+// or lower case.
+//
+// A UUID is only matched if it is not directly preceded or followed by a
+// letter or digit, so that e.g. hexadecimal hashes are not mistaken for UUIDs.
+type Extractor struct {
+	// Braced includes the surrounding braces of UUIDs formatted as
+	// "{9e754ef6-8dd9-4903-af43-7aea99bfb1fe}" in the returned indexes.
+	Braced bool
+	// Dashless additionally matches UUIDs formatted as 32 hexadecimal
+	// characters without dashes.
+	Dashless bool
+}
+
+// Extract returns all UUIDs found in s, in the order they appear.
+func (e Extractor) Extract(s string) []UUID {
+	var out []UUID
+	e.find(s, -1, func(u UUID, _, _ int) {
+		out = append(out, u)
+	})
+	return out
+}
+
+// FindAllIndex returns the start and end indexes of up to n UUIDs found in s,
+// in the same form as regexp.Regexp.FindAllStringIndex. If n is negative, all
+// UUIDs are returned.
+func (e Extractor) FindAllIndex(s string, n int) [][]int {
+	var out [][]int
+	e.find(s, n, func(_ UUID, start, end int) {
+		out = append(out, []int{start, end})
+	})
+	return out
+}
+
+// find calls fn with each of up to n UUIDs found in s, along with its start
+// and end indexes.
+func (e Extractor) find(s string, n int, fn func(u UUID, start, end int)) {
+	for i := 0; i+32 <= len(s) && n != 0; i++ {
+		if hexValues[s[i]] > 0x0f || (i > 0 && isAlnum(s[i-1])) {
+			continue
+		}
+		size := e.match(s, i)
+		if size == 0 {
+			continue
+		}
+		u, _ := parse(s[i : i+size])
+		start, end := i, i+size
+		if e.Braced && start > 0 && end < len(s) && s[start-1] == '{' && s[end] == '}' {
+			start, end = start-1, end+1
+		}
+		fn(u, start, end)
+		n--
+		i += size - 1
+	}
+}
+
+// match returns the length of the UUID starting at offset i of s, or zero if
+// there is no UUID at i.
+func (e Extractor) match(s string, i int) int {
+	if i+36 <= len(s) && (i+36 == len(s) || !isAlnum(s[i+36])) {
+		if _, err := parseFormatted(s[i : i+36]); err == nil {
+			return 36
+		}
+	}
+	if e.Dashless && (i+32 == len(s) || !isAlnum(s[i+32])) {
+		var u UUID
+		if decodeHex(u[:], s[i:i+32]) {
+			return 32
+		}
+	}
+	return 0
+}
+
+func isAlnum(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
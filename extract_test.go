@@ -0,0 +1,64 @@
+package uuid
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	const (
+		u1 = "9e754ef6-8dd9-4903-af43-7aea99bfb1fe"
+		u2 = "6BA7B810-9DAD-11D1-80B4-00C04FD430C8"
+		u3 = "9e754ef68dd94903af437aea99bfb1fe"
+	)
+	s := "req=" + u1 + " user={" + u2 + "} hash=" + u3 + " sha=" + u3 + u3 + " x" + u1 + " " + u1
+
+	ids := Extract(s)
+	exp := []UUID{Must(ParseString(u1)), Must(ParseString(u2)), Must(ParseString(u1))}
+	if !reflect.DeepEqual(ids, exp) {
+		t.Fatalf("Unexpected extracted UUIDs: %v", ids)
+	}
+
+	idx := FindAllIndex(s, -1)
+	if len(idx) != 3 {
+		t.Fatalf("Unexpected number of indexes: %v", idx)
+	}
+	for i, loc := range idx {
+		if got := Must(ParseString(s[loc[0]:loc[1]])); got != exp[i] {
+			t.Fatalf("Unexpected UUID at index %v: %s", loc, got)
+		}
+	}
+	if idx = FindAllIndex(s, 2); len(idx) != 2 {
+		t.Fatalf("Unexpected number of limited indexes: %v", idx)
+	}
+	if idx = FindAllIndex(s, 0); idx != nil {
+		t.Fatalf("Unexpected indexes with zero limit: %v", idx)
+	}
+
+	e := Extractor{Braced: true, Dashless: true}
+	ids = e.Extract(s)
+	exp = []UUID{Must(ParseString(u1)), Must(ParseString(u2)), Must(ParseString(u3)), Must(ParseString(u1))}
+	if !reflect.DeepEqual(ids, exp) {
+		t.Fatalf("Unexpected extracted UUIDs with options: %v", ids)
+	}
+	idx = e.FindAllIndex(s, -1)
+	if s[idx[1][0]:idx[1][1]] != "{"+u2+"}" || s[idx[2][0]:idx[2][1]] != u3 {
+		t.Fatalf("Unexpected indexes with options: %v", idx)
+	}
+
+	for _, s := range []string{"", "no uuids here", u1[:35], u3, "{" + u1[1:] + "}"} {
+		if ids := Extract(s); ids != nil {
+			t.Fatalf("Unexpected UUIDs extracted from %q: %v", s, ids)
+		}
+	}
+	if ids := Extract(u1); len(ids) != 1 {
+		t.Fatalf("Unexpected UUIDs extracted from %q: %v", u1, ids)
+	}
+}
+
+func BenchmarkExtract(b *testing.B) {
+	s := "2024-05-02T16:37:34Z INFO request completed id=9e754ef6-8dd9-4903-af43-7aea99bfb1fe status=200 duration=12ms"
+	for i := 0; i < b.N; i++ {
+		_ = Extract(s)
+	}
+}
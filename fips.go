@@ -0,0 +1,19 @@
+package uuid
+
+// FIPSMode reports whether the package is operating in FIPS mode, in which
+// NewV3 panics rather than using MD5. FIPS mode is enabled when building with
+// the "uuid_fips" build tag, or, with Go 1.26 and later, when strict FIPS 140-3
+// enforcement is enabled with GODEBUG=fips140=only. As with the standard
+// library, GODEBUG=fips140=on alone does not enable it.
+//
+// In FIPS mode, NewV8SHA256 should be used to generate name-based UUIDs.
+func FIPSMode() bool {
+	return fipsBuild || fips140Enforced()
+}
+
+// NewV8SHA256 uses the provided namespace and name to generate and return a
+// new name-based V8 UUID using SHA-256 hashing, as per RFC 9562, appendix B.2.
+// It is the recommended way to generate name-based UUIDs in FIPS mode.
+func NewV8SHA256(namespace UUID, name []byte) UUID {
+	return usingHash(&sha256Pool, namespace, name, 8)
+}
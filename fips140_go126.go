@@ -0,0 +1,9 @@
+//go:build go1.26
+
+package uuid
+
+import "crypto/fips140"
+
+func fips140Enforced() bool {
+	return fips140.Enforced()
+}
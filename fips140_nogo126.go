@@ -0,0 +1,7 @@
+//go:build !go1.26
+
+package uuid
+
+func fips140Enforced() bool {
+	return false
+}
@@ -0,0 +1,5 @@
+//go:build uuid_fips
+
+package uuid
+
+const fipsBuild = true
@@ -0,0 +1,40 @@
+package uuid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewV8SHA256(t *testing.T) {
+	// Test vector from RFC 9562, appendix B.2.
+	u := NewV8SHA256(namespaceDNS, []byte("www.example.com"))
+	if s := u.String(); s != "5c146b14-3c52-8afd-938a-375d0df1fbf6" {
+		t.Fatalf("Unexpected NewV8SHA256 result: %s", s)
+	}
+	verifyVersion(t, u, 8)
+	verifyVariant(t, u)
+
+	if NewV8SHA256(namespaceDNS, []byte("www.example.org")) == u {
+		t.Fatal("Unexpected equal UUIDs for different names")
+	}
+
+	name := []byte("test")
+	allocs := testing.AllocsPerRun(100, func() { _ = NewV8SHA256(namespaceDNS, name) })
+	if allocs != 0 {
+		t.Fatalf("Unexpected allocations in NewV8SHA256: %v", allocs)
+	}
+}
+
+func TestFIPSModeNewV3(t *testing.T) {
+	if !FIPSMode() {
+		t.Skip("not in FIPS mode")
+	}
+	defer func() {
+		r := recover()
+		if s, ok := r.(string); !ok || !strings.Contains(s, "FIPS mode") {
+			t.Fatalf("Unexpected panic value: %v", r)
+		}
+	}()
+	NewV3(namespaceDNS, []byte("www.example.com"))
+	t.Fatal("Expected NewV3 to panic in FIPS mode")
+}
@@ -0,0 +1,64 @@
+package uuid
+
+import "strings"
+
+// Set reads the textual UUID s into u, accepting the same representations as
+// ParseLenient. Together with String and Type, it allows a *UUID to be used
+// as a flag.Value or a github.com/spf13/pflag Value:
+//
+//	var id uuid.UUID
+//	flag.Var(&id, "id", "the id to look up")
+func (u *UUID) Set(s string) error {
+	id, err := ParseLenient(s)
+	if err != nil {
+		return err
+	}
+	*u = id
+	return nil
+}
+
+// Type returns the name of the flag value type, as required by pflag.
+func (u *UUID) Type() string {
+	return "uuid"
+}
+
+// UUIDSlice is a flag.Value and pflag Value holding a list of UUIDs. Each call
+// to Set appends one or more comma-separated UUIDs, allowing a flag to be
+// repeated:
+//
+//	var ids uuid.UUIDSlice
+//	flag.Var(&ids, "id", "the ids to look up (may be repeated)")
+type UUIDSlice []UUID
+
+// String returns the comma-separated UUIDs in the slice.
+func (s *UUIDSlice) String() string {
+	if s == nil {
+		return ""
+	}
+	strs := make([]string, len(*s))
+	for i, u := range *s {
+		strs[i] = u.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+// Set parses the comma-separated UUIDs in v and appends them to the slice. If
+// any UUID is invalid, an error is returned and the slice is unchanged.
+func (s *UUIDSlice) Set(v string) error {
+	parts := strings.Split(v, ",")
+	out := make([]UUID, len(parts))
+	for i, part := range parts {
+		u, err := ParseLenient(strings.TrimSpace(part))
+		if err != nil {
+			return err
+		}
+		out[i] = u
+	}
+	*s = append(*s, out...)
+	return nil
+}
+
+// Type returns the name of the flag value type, as required by pflag.
+func (s *UUIDSlice) Type() string {
+	return "uuidSlice"
+}
@@ -0,0 +1,65 @@
+package uuid
+
+import (
+	"flag"
+	"io"
+	"testing"
+)
+
+type pflagValue interface {
+	flag.Value
+	Type() string
+}
+
+var (
+	_ pflagValue = (*UUID)(nil)
+	_ pflagValue = (*UUIDSlice)(nil)
+)
+
+func TestFlag(t *testing.T) {
+	u1, u2, u3 := newUUID(), newUUID(), newUUID()
+
+	var id UUID
+	var ids UUIDSlice
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(&id, "id", "")
+	fs.Var(&ids, "ids", "")
+
+	err := fs.Parse([]string{
+		"-id", u1.String(),
+		"-ids", u2.String(),
+		"-ids", u3.String() + ", " + u1.String(),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected flag parsing error: %s", err.Error())
+	}
+	if id != u1 {
+		t.Fatalf("Unexpected id flag value: %s", id)
+	}
+	if len(ids) != 3 || ids[0] != u2 || ids[1] != u3 || ids[2] != u1 {
+		t.Fatalf("Unexpected ids flag value: %s", ids.String())
+	}
+	if s := ids.String(); s != u2.String()+","+u3.String()+","+u1.String() {
+		t.Fatalf("Unexpected ids string: %s", s)
+	}
+	if id.Type() != "uuid" || ids.Type() != "uuidSlice" {
+		t.Fatalf("Unexpected flag types: %s, %s", id.Type(), ids.Type())
+	}
+
+	if err = fs.Parse([]string{"-id", "invalid"}); err == nil {
+		t.Fatal("Unexpected pass parsing invalid id flag")
+	}
+	if err = fs.Parse([]string{"-id="}); err == nil {
+		t.Fatal("Unexpected pass parsing empty id flag")
+	}
+	if id != u1 {
+		t.Fatalf("Unexpected id modified after error: %s", id)
+	}
+	if err = ids.Set(u1.String() + ",invalid"); err == nil {
+		t.Fatal("Unexpected pass parsing invalid ids flag")
+	}
+	if len(ids) != 3 {
+		t.Fatalf("Unexpected ids modified after error: %s", ids.String())
+	}
+}
@@ -0,0 +1,14 @@
+//go:build amd64 && !purego && !tinygo
+
+package uuid
+
+//go:noescape
+func formatSliceSSE2(dst *byte, ids *UUID, n int)
+
+func formatSlice(dst []byte, ids []UUID) {
+	if len(ids) == 0 {
+		return
+	}
+	_ = dst[formattedLineLen*len(ids)-1]
+	formatSliceSSE2(&dst[0], &ids[0], len(ids))
+}
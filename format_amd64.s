@@ -0,0 +1,82 @@
+//go:build amd64 && !purego && !tinygo
+
+#include "textflag.h"
+
+// func formatSliceSSE2(dst *byte, ids *UUID, n int)
+//
+// Each UUID is split into its high and low nibbles, which are converted to
+// lowercase hexadecimal characters by adding '0', plus 'a'-'0'-10 for nibbles
+// greater than 9. The characters are then interleaved and stored with dashes
+// and a trailing newline.
+TEXT ·formatSliceSSE2(SB), NOSPLIT, $0-24
+	MOVQ dst+0(FP), DI
+	MOVQ ids+8(FP), SI
+	MOVQ n+16(FP), CX
+
+	MOVQ       $0x0f0f0f0f0f0f0f0f, AX
+	MOVQ       AX, X8
+	PUNPCKLQDQ X8, X8
+	MOVQ       $0x0909090909090909, AX
+	MOVQ       AX, X9
+	PUNPCKLQDQ X9, X9
+	MOVQ       $0x2727272727272727, AX
+	MOVQ       AX, X10
+	PUNPCKLQDQ X10, X10
+	MOVQ       $0x3030303030303030, AX
+	MOVQ       AX, X11
+	PUNPCKLQDQ X11, X11
+
+	TESTQ CX, CX
+	JEQ   done
+
+loop:
+	// X1 holds the high nibbles, X0 the low nibbles.
+	MOVOU (SI), X0
+	MOVO  X0, X1
+	PSRLW $4, X1
+	PAND  X8, X1
+	PAND  X8, X0
+
+	// Convert the nibbles to hexadecimal characters.
+	MOVO    X1, X2
+	PCMPGTB X9, X2
+	PAND    X10, X2
+	PADDB   X11, X1
+	PADDB   X2, X1
+	MOVO    X0, X3
+	PCMPGTB X9, X3
+	PAND    X10, X3
+	PADDB   X11, X0
+	PADDB   X3, X0
+
+	// Interleave the characters: X4 holds characters 0-15, X1 16-31.
+	MOVO      X1, X4
+	PUNPCKLBW X0, X4
+	PUNPCKHBW X0, X1
+
+	// Store the characters, inserting dashes and a newline.
+	MOVQ   X4, (DI)
+	MOVB   $0x2d, 8(DI)
+	PSRLDQ $8, X4
+	MOVQ   X4, AX
+	MOVL   AX, 9(DI)
+	MOVB   $0x2d, 13(DI)
+	SHRQ   $32, AX
+	MOVL   AX, 14(DI)
+	MOVB   $0x2d, 18(DI)
+	MOVQ   X1, AX
+	MOVL   AX, 19(DI)
+	MOVB   $0x2d, 23(DI)
+	SHRQ   $32, AX
+	MOVL   AX, 24(DI)
+	PSRLDQ $8, X1
+	MOVQ   X1, 28(DI)
+	MOVB   $0x0a, 36(DI)
+
+	ADDQ $16, SI
+	ADDQ $37, DI
+	DECQ CX
+	JNZ  loop
+
+done:
+	RET
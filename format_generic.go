@@ -0,0 +1,7 @@
+//go:build !amd64 || purego || tinygo
+
+package uuid
+
+func formatSlice(dst []byte, ids []UUID) {
+	formatSliceGeneric(dst, ids)
+}
@@ -0,0 +1,80 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFormatSliceInto(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 7, 100} {
+		ids := make([]UUID, n)
+		for i := range ids {
+			ids[i] = newUUID()
+		}
+		if n > 2 {
+			ids[1] = UUID{}
+			for i := range ids[2] {
+				ids[2][i] = 0xff
+			}
+		}
+
+		var exp bytes.Buffer
+		for _, u := range ids {
+			exp.WriteString(u.String())
+			exp.WriteByte('\n')
+		}
+
+		dst := make([]byte, 37*n+3)
+		written := FormatSliceInto(dst, ids)
+		if written != 37*n {
+			t.Fatalf("Unexpected number of bytes written: %d", written)
+		}
+		if !bytes.Equal(dst[:written], exp.Bytes()) {
+			t.Fatalf("Unexpected formatted UUIDs:\n%s", dst[:written])
+		}
+		if !bytes.Equal(dst[written:], []byte{0, 0, 0}) {
+			t.Fatalf("Unexpected bytes written past end: %x", dst[written:])
+		}
+
+		generic := make([]byte, 37*n)
+		formatSliceGeneric(generic, ids)
+		if !bytes.Equal(generic, exp.Bytes()) {
+			t.Fatalf("Unexpected generic formatted UUIDs:\n%s", generic)
+		}
+	}
+}
+
+func TestFormatSliceIntoShort(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Expected panic with short destination")
+		}
+	}()
+	FormatSliceInto(make([]byte, 36), []UUID{newUUID()})
+}
+
+func BenchmarkFormatSliceInto(b *testing.B) {
+	ids := make([]UUID, 1024)
+	for i := range ids {
+		ids[i] = newUUID()
+	}
+	dst := make([]byte, 37*len(ids))
+	b.SetBytes(int64(len(dst)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FormatSliceInto(dst, ids)
+	}
+}
+
+func BenchmarkFormatSliceGeneric(b *testing.B) {
+	ids := make([]UUID, 1024)
+	for i := range ids {
+		ids[i] = newUUID()
+	}
+	dst := make([]byte, 37*len(ids))
+	b.SetBytes(int64(len(dst)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		formatSliceGeneric(dst, ids)
+	}
+}
@@ -0,0 +1,115 @@
+//go:build ignore
+
+// This program generates wrappers_gen.go, which implements the methods shared
+// by the wrapper types of UUID by delegating them to UUID. Methods that a
+// wrapper type implements itself are skipped. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+)
+
+// wrappers holds each wrapper type of UUID, its receiver name, and the shared
+// methods it implements itself.
+var wrappers = []struct {
+	typ    string
+	recv   string
+	custom []string
+}{
+	{typ: "ID[T]", recv: "id"},
+	{typ: "BinaryUUID", recv: "b", custom: []string{"Value"}},
+	{typ: "StringUUID", recv: "s", custom: []string{"Value"}},
+	{typ: "DecimalUUID", recv: "d", custom: []string{"Value", "Scan"}},
+	{typ: "RequiredUUID", recv: "r"},
+	{typ: "OptionalUUID", recv: "o", custom: []string{
+		"MarshalText", "UnmarshalText",
+		"MarshalJSON", "UnmarshalJSON",
+		"MarshalBinary", "UnmarshalBinary",
+		"Scan",
+	}},
+	{typ: "CompactUUID", recv: "c", custom: []string{"MarshalJSON", "UnmarshalJSON"}},
+}
+
+// methods holds the source of each shared method, formatted with the receiver
+// name and type.
+var methods = []struct {
+	name string
+	src  string
+}{
+	{"String", `// String returns the human-readable, hexadecimal format of the UUID.
+func (%[1]s %[2]s) String() string {
+	return UUID(%[1]s).String()
+}`},
+	{"IsZero", `// IsZero returns true if the UUID contains all zeros (the default value).
+func (%[1]s %[2]s) IsZero() bool {
+	return UUID(%[1]s).IsZero()
+}`},
+	{"MarshalText", `// MarshalText implements the TextMarshaler interface.
+func (%[1]s %[2]s) MarshalText() ([]byte, error) {
+	return UUID(%[1]s).MarshalText()
+}`},
+	{"UnmarshalText", `// UnmarshalText implements the TextUnmarshaler interface.
+func (%[1]s *%[2]s) UnmarshalText(text []byte) error {
+	return (*UUID)(%[1]s).UnmarshalText(text)
+}`},
+	{"MarshalJSON", `// MarshalJSON implements the json Marshaler interface.
+func (%[1]s %[2]s) MarshalJSON() ([]byte, error) {
+	return UUID(%[1]s).MarshalJSON()
+}`},
+	{"UnmarshalJSON", `// UnmarshalJSON implements the json Unmarshaler interface.
+func (%[1]s *%[2]s) UnmarshalJSON(data []byte) error {
+	return (*UUID)(%[1]s).UnmarshalJSON(data)
+}`},
+	{"MarshalBinary", `// MarshalBinary implements the BinaryMarshaler interface.
+func (%[1]s %[2]s) MarshalBinary() ([]byte, error) {
+	return UUID(%[1]s).MarshalBinary()
+}`},
+	{"UnmarshalBinary", `// UnmarshalBinary implements the BinaryUnmarshaler interface.
+func (%[1]s *%[2]s) UnmarshalBinary(data []byte) error {
+	return (*UUID)(%[1]s).UnmarshalBinary(data)
+}`},
+	{"Value", `// Value implements the sql driver Valuer interface.
+func (%[1]s %[2]s) Value() (driver.Value, error) {
+	return UUID(%[1]s).Value()
+}`},
+	{"Scan", `// Scan implements the sql Scanner interface.
+func (%[1]s *%[2]s) Scan(src interface{}) error {
+	return (*UUID)(%[1]s).Scan(src)
+}`},
+}
+
+func main() {
+	src, err := generate()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile("wrappers_gen.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the formatted Go source of the shared wrapper methods.
+func generate() ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen_wrappers.go. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package uuid\n\n")
+	fmt.Fprintf(&buf, "import \"database/sql/driver\"\n")
+
+	for _, w := range wrappers {
+		custom := make(map[string]bool, len(w.custom))
+		for _, name := range w.custom {
+			custom[name] = true
+		}
+		for _, m := range methods {
+			if !custom[m.name] {
+				fmt.Fprintf(&buf, "\n"+m.src+"\n", w.recv, w.typ)
+			}
+		}
+	}
+
+	return format.Source(buf.Bytes())
+}
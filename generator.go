@@ -0,0 +1,285 @@
+package uuid
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Generator is the interface implemented by types that generate random (V4)
+// and time-based (V7) UUIDs. Implementations must be safe for concurrent use.
+type Generator interface {
+	NewV4() (UUID, error)
+	NewV7(now time.Time) (UUID, error)
+}
+
+// NewGenerator returns a Generator that reads random bytes from r, as per
+// NewV4FromRand and NewV7FromRand. The provided io.Reader must be safe for
+// concurrent use.
+func NewGenerator(r io.Reader) Generator {
+	return randGenerator{r: r}
+}
+
+type randGenerator struct {
+	r io.Reader
+}
+
+func (g randGenerator) NewV4() (UUID, error) {
+	return NewV4FromRand(g.r)
+}
+
+func (g randGenerator) NewV7(now time.Time) (UUID, error) {
+	return NewV7FromRand(now, g.r)
+}
+
+var (
+	cryptoGenerator  = NewGenerator(entropy)
+	defaultGenerator atomic.Pointer[Generator]
+)
+
+// Default returns the Generator used by the package-level NewV4 and NewV7
+// functions. Unless changed with SetDefault, it reads random bytes from
+// "crypto/rand", or only from the function installed with SetRandFunc when
+// building with TinyGo.
+func Default() Generator {
+	if g := defaultGenerator.Load(); g != nil {
+		return *g
+	}
+	return cryptoGenerator
+}
+
+// SetDefault sets the Generator used by the package-level NewV4 and NewV7
+// functions, allowing an application to install a custom generator without
+// changing every call site. If g is nil, the "crypto/rand" based generator is
+// restored. It is safe to call concurrently with generating UUIDs.
+func SetDefault(g Generator) {
+	if g == nil {
+		defaultGenerator.Store(nil)
+		return
+	}
+	defaultGenerator.Store(&g)
+}
+
+const (
+	// MinCounterBits is the minimum counter width of a V7Generator, filling
+	// the 12-bit "rand_a" field of a V7 UUID.
+	MinCounterBits = 12
+	// MaxCounterBits is the maximum counter width of a V7Generator, filling
+	// the "rand_a" field and spanning 14 bits into the "rand_b" field.
+	MaxCounterBits = 26
+)
+
+// ErrCounterOverflow represents the error returned by a V7Generator using
+// RolloverError when its counter is exhausted within a single millisecond.
+var ErrCounterOverflow = errors.New("uuid: v7 counter overflow")
+
+// Rollover determines the behavior of a V7Generator when its counter
+// overflows within a single millisecond.
+type Rollover int
+
+const (
+	// RolloverIncrementTime advances the embedded timestamp by one
+	// millisecond and reinitializes the counter, as recommended by RFC 9562.
+	// This preserves ordering at the cost of the embedded timestamp running
+	// ahead of the clock while the generation rate remains high.
+	RolloverIncrementTime Rollover = iota
+	// RolloverError returns ErrCounterOverflow until the clock advances to
+	// the next millisecond, keeping the embedded timestamp accurate.
+	RolloverError
+)
+
+// V7Generator generates monotonically increasing V7 UUIDs using a fixed
+// bit-length dedicated counter, as described in RFC 9562, section 6.2.
+//
+// The counter occupies the most significant bits following the version
+// field, and is initialized to a random value with its most significant bit
+// cleared each time the millisecond changes, leaving at least half of the
+// counter space for UUIDs generated within the same millisecond. Wider
+// counters support higher generation rates within a millisecond at the cost
+// of fewer random bits per UUID.
+//
+// If the provided timestamp is before that of the previously generated UUID,
+// e.g. due to the clock moving backwards, the previous timestamp continues to
+// be used to preserve ordering.
+//
+// A V7Generator is safe for concurrent use.
+type V7Generator struct {
+	mu       sync.Mutex
+	r        io.Reader
+	bits     uint
+	rollover Rollover
+	counter  v7Counter
+}
+
+// NewV7Generator returns a new V7Generator that reads random bytes from r,
+// uses a counter of counterBits bits, and handles counter overflows according
+// to rollover. It panics if counterBits is not in the range
+// [MinCounterBits, MaxCounterBits].
+func NewV7Generator(r io.Reader, counterBits int, rollover Rollover) *V7Generator {
+	checkCounterBits(counterBits)
+	return &V7Generator{r: r, bits: uint(counterBits), rollover: rollover}
+}
+
+// NewV4 generates and returns a new v4 UUID using random bytes read from the
+// generator's io.Reader.
+func (g *V7Generator) NewV4() (UUID, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return NewV4FromRand(g.r)
+}
+
+// NewV7 uses the provided timestamp to generate and return a new V7 UUID that
+// is greater than all UUIDs previously returned by the generator.
+func (g *V7Generator) NewV7(now time.Time) (UUID, error) {
+	var u UUID
+	ms := now.UnixMilli()
+	if ms < 0 || ms > maxV7Millis {
+		return u, ErrTimeOutOfRange
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if _, err := io.ReadFull(g.r, u[6:]); err != nil {
+		return UUID{}, err
+	}
+	ms, c, err := g.counter.next(ms, maxV7Millis, getBits(&u, 0, g.bits), g.bits, g.rollover)
+	if err != nil {
+		return UUID{}, err
+	}
+
+	setMillis(&u, ms)
+	setBits(&u, 0, g.bits, c)
+	setVersion(&u, 7)
+	setVariant(&u)
+	return u, nil
+}
+
+// V7GeneratorState is a snapshot of the state of a V7Generator.
+type V7GeneratorState struct {
+	// Time is the timestamp of the most recently generated V7 UUID, or the
+	// zero Time if no V7 UUIDs have been generated.
+	Time time.Time
+	// Counter is the counter value of the most recently generated V7 UUID.
+	Counter uint32
+	// CounterBits is the width of the counter.
+	CounterBits int
+	// Rollbacks is the number of times a timestamp before that of the
+	// previously generated UUID was provided, e.g. due to the clock moving
+	// backwards.
+	Rollbacks uint64
+	// Overflows is the number of times the counter was exhausted within a
+	// single millisecond.
+	Overflows uint64
+}
+
+// State returns a snapshot of the current state of the generator.
+func (g *V7Generator) State() V7GeneratorState {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	s := V7GeneratorState{
+		Counter:     g.counter.counter,
+		CounterBits: int(g.bits),
+		Rollbacks:   g.counter.rollbacks,
+		Overflows:   g.counter.overflows,
+	}
+	if g.counter.valid {
+		s.Time = time.UnixMilli(g.counter.tick)
+	}
+	return s
+}
+
+// checkCounterBits panics if bits is not a valid counter width.
+func checkCounterBits(bits int) {
+	if bits < MinCounterBits || bits > MaxCounterBits {
+		panic("uuid: invalid v7 counter width")
+	}
+}
+
+// v7Counter holds the state of a fixed bit-length dedicated counter, along
+// with the timestamp, in ticks, that it was last used with. The number of
+// times the clock moved backwards and the counter overflowed are also counted.
+type v7Counter struct {
+	valid     bool
+	tick      int64
+	counter   uint32
+	rollbacks uint64
+	overflows uint64
+}
+
+// next returns the timestamp and counter to use for a UUID generated at the
+// provided tick, updating the state of the counter. The random value is used
+// to initialize the counter, with its most significant bit cleared.
+func (c *v7Counter) next(tick, maxTick int64, random uint32, bits uint, rollover Rollover) (int64, uint32, error) {
+	maxCounter := uint32(1)<<bits - 1
+	if c.valid && tick < c.tick {
+		c.rollbacks++
+	}
+	switch {
+	case !c.valid || tick > c.tick:
+		c.valid = true
+		c.tick = tick
+		c.counter = random & (maxCounter >> 1)
+	case c.counter < maxCounter:
+		c.counter++
+	case rollover == RolloverError:
+		c.overflows++
+		return 0, 0, ErrCounterOverflow
+	case c.tick >= maxTick:
+		return 0, 0, ErrTimeOutOfRange
+	default:
+		c.overflows++
+		c.tick++
+		c.counter = random & (maxCounter >> 1)
+	}
+	return c.tick, c.counter, nil
+}
+
+// payload returns the first 64 bits following the version field of the UUID
+// pointed to by u, skipping the variant bits.
+func payload(u *UUID) uint64 {
+	return uint64(u[6]&0x0f)<<60 | uint64(u[7])<<52 | uint64(u[8]&0x3f)<<46 |
+		uint64(u[9])<<38 | uint64(u[10])<<30 | uint64(u[11])<<22 |
+		uint64(u[12])<<14 | uint64(u[13])<<6 | uint64(u[14])>>2
+}
+
+// setPayload sets the first 64 bits following the version field of the UUID
+// pointed to by u, skipping the variant bits.
+func setPayload(u *UUID, p uint64) {
+	u[6] = u[6]&0xf0 | byte(p>>60)
+	u[7] = byte(p >> 52)
+	u[8] = u[8]&0xc0 | byte(p>>46)&0x3f
+	u[9] = byte(p >> 38)
+	u[10] = byte(p >> 30)
+	u[11] = byte(p >> 22)
+	u[12] = byte(p >> 14)
+	u[13] = byte(p >> 6)
+	u[14] = byte(p<<2) | u[14]&0x03
+}
+
+// getBits returns the value of n bits, starting at offset bits after the
+// version field of the UUID pointed to by u.
+func getBits(u *UUID, offset, n uint) uint32 {
+	return uint32(payload(u) >> (64 - offset - n) & (1<<n - 1))
+}
+
+// setBits sets n bits, starting at offset bits after the version field of the
+// UUID pointed to by u, to v, leaving the remaining bits unchanged.
+func setBits(u *UUID, offset, n uint, v uint32) {
+	shift := 64 - offset - n
+	mask := uint64(1)<<n - 1
+	setPayload(u, payload(u)&^(mask<<shift)|(uint64(v)&mask)<<shift)
+}
+
+// setMillis sets the 48-bit Unix millisecond timestamp in the UUID pointed to
+// by u.
+func setMillis(u *UUID, ms int64) {
+	u[0] = byte(ms >> 40)
+	u[1] = byte(ms >> 32)
+	u[2] = byte(ms >> 24)
+	u[3] = byte(ms >> 16)
+	u[4] = byte(ms >> 8)
+	u[5] = byte(ms)
+}
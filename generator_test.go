@@ -0,0 +1,169 @@
+package uuid
+
+import (
+	"bytes"
+	"crypto/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestV7Generator(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	for bits := MinCounterBits; bits <= MaxCounterBits; bits++ {
+		g := NewV7Generator(rand.Reader, bits, RolloverIncrementTime)
+		prev := Must(g.NewV7(now))
+		verifyVariant(t, prev)
+		verifyVersion(t, prev, 7)
+		for i := 0; i < 1000; i++ {
+			u := Must(g.NewV7(now))
+			verifyVariant(t, u)
+			verifyVersion(t, u, 7)
+			if bytes.Compare(prev[:], u[:]) >= 0 {
+				t.Fatalf("UUIDs not increasing with %d bit counter: %s vs %s", bits, prev, u)
+			}
+			if getBits(&u, 0, uint(bits)) != getBits(&prev, 0, uint(bits))+1 {
+				t.Fatalf("Counter not incremented with %d bit counter: %s vs %s", bits, prev, u)
+			}
+			prev = u
+		}
+	}
+}
+
+func TestV7GeneratorClockBackwards(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	g := NewV7Generator(rand.Reader, MinCounterBits, RolloverIncrementTime)
+	u1 := Must(g.NewV7(now))
+	u2 := Must(g.NewV7(now.Add(-time.Second)))
+	if bytes.Compare(u1[:], u2[:]) >= 0 {
+		t.Fatalf("UUIDs not increasing: %s vs %s", u1, u2)
+	}
+	if ut, _ := u2.Time(); !ut.Equal(now) {
+		t.Fatalf("Unexpected time after clock moved backwards: %v", ut)
+	}
+}
+
+func TestV7GeneratorRollover(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+
+	g := NewV7Generator(rand.Reader, MinCounterBits, RolloverIncrementTime)
+	prev := Must(g.NewV7(now))
+	for i := 0; i < 1<<MinCounterBits; i++ {
+		u := Must(g.NewV7(now))
+		if bytes.Compare(prev[:], u[:]) >= 0 {
+			t.Fatalf("UUIDs not increasing: %s vs %s", prev, u)
+		}
+		prev = u
+	}
+	if ut, _ := prev.Time(); !ut.After(now) {
+		t.Fatalf("Expected time to be incremented on rollover: %v", ut)
+	}
+
+	g = NewV7Generator(rand.Reader, MinCounterBits, RolloverError)
+	var err error
+	for i := 0; i <= 1<<MinCounterBits && err == nil; i++ {
+		_, err = g.NewV7(now)
+	}
+	if err != ErrCounterOverflow {
+		t.Fatalf("Unexpected error on counter overflow: %v", err)
+	}
+	u, err := g.NewV7(now.Add(time.Millisecond))
+	if err != nil {
+		t.Fatalf("Unexpected error after clock advanced: %s", err.Error())
+	}
+	if ut, _ := u.Time(); !ut.Equal(now.Add(time.Millisecond)) {
+		t.Fatalf("Unexpected time after clock advanced: %v", ut)
+	}
+}
+
+func TestV7GeneratorState(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	g := NewV7Generator(rand.Reader, MinCounterBits, RolloverError)
+	if s := g.State(); !s.Time.IsZero() || s.CounterBits != MinCounterBits {
+		t.Fatalf("Unexpected initial state: %+v", s)
+	}
+
+	u := Must(g.NewV7(now))
+	s := g.State()
+	if !s.Time.Equal(now) || s.Counter != getBits(&u, 0, MinCounterBits) {
+		t.Fatalf("Unexpected state after generation: %+v", s)
+	}
+
+	_ = Must(g.NewV7(now.Add(-time.Second)))
+	if s = g.State(); s.Rollbacks != 1 || !s.Time.Equal(now) {
+		t.Fatalf("Unexpected state after clock moved backwards: %+v", s)
+	}
+
+	var err error
+	for err == nil {
+		_, err = g.NewV7(now)
+	}
+	if s = g.State(); s.Overflows != 1 || s.Counter != 1<<MinCounterBits-1 {
+		t.Fatalf("Unexpected state after counter overflow: %+v", s)
+	}
+}
+
+func TestNewV7GeneratorInvalidBits(t *testing.T) {
+	for _, bits := range []int{MinCounterBits - 1, MaxCounterBits + 1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("Expected panic with %d bit counter", bits)
+				}
+			}()
+			NewV7Generator(rand.Reader, bits, RolloverIncrementTime)
+		}()
+	}
+}
+
+func BenchmarkV7Generator(b *testing.B) {
+	g := NewV7Generator(rand.Reader, MinCounterBits, RolloverIncrementTime)
+	now := time.Unix(1000, 0)
+	for i := 0; i < b.N; i++ {
+		_, _ = g.NewV7(now)
+	}
+}
+
+// countingGenerator is a Generator that counts the UUIDs it generates.
+type countingGenerator struct {
+	Generator
+	n atomic.Int64
+}
+
+func (g *countingGenerator) NewV4() (UUID, error) {
+	g.n.Add(1)
+	return g.Generator.NewV4()
+}
+
+func (g *countingGenerator) NewV7(now time.Time) (UUID, error) {
+	g.n.Add(1)
+	return g.Generator.NewV7(now)
+}
+
+func TestSetDefault(t *testing.T) {
+	if Default() != cryptoGenerator {
+		t.Fatal("Unexpected initial default generator")
+	}
+
+	g := &countingGenerator{Generator: NewV7Generator(rand.Reader, MinCounterBits, RolloverIncrementTime)}
+	SetDefault(g)
+	defer SetDefault(nil)
+	if Default() != g {
+		t.Fatal("Default generator not set")
+	}
+
+	verifyVersion(t, Must(NewV4()), 4)
+	now := time.Now()
+	u1, u2 := Must(NewV7(now)), Must(NewV7(now))
+	if bytes.Compare(u1[:], u2[:]) >= 0 {
+		t.Fatalf("UUIDs not increasing with monotonic default generator: %s vs %s", u1, u2)
+	}
+	if n := g.n.Load(); n != 3 {
+		t.Fatalf("Unexpected number of UUIDs from default generator: %d", n)
+	}
+
+	SetDefault(nil)
+	if Default() != cryptoGenerator {
+		t.Fatal("Default generator not restored")
+	}
+}
@@ -0,0 +1,103 @@
+package uuid
+
+// Well-known GPT partition type GUIDs.
+var (
+	// PartitionTypeUnused is 00000000-0000-0000-0000-000000000000.
+	PartitionTypeUnused = UUID{}
+	// PartitionTypeEFISystem is c12a7328-f81f-11d2-ba4b-00a0c93ec93b.
+	PartitionTypeEFISystem = UUID{0xc1, 0x2a, 0x73, 0x28, 0xf8, 0x1f, 0x11, 0xd2, 0xba, 0x4b, 0x00, 0xa0, 0xc9, 0x3e, 0xc9, 0x3b}
+	// PartitionTypeBIOSBoot is 21686148-6449-6e6f-744e-656564454649.
+	PartitionTypeBIOSBoot = UUID{0x21, 0x68, 0x61, 0x48, 0x64, 0x49, 0x6e, 0x6f, 0x74, 0x4e, 0x65, 0x65, 0x64, 0x45, 0x46, 0x49}
+	// PartitionTypeMicrosoftReserved is e3c9e316-0b5c-4db8-817d-f92df00215ae.
+	PartitionTypeMicrosoftReserved = UUID{0xe3, 0xc9, 0xe3, 0x16, 0x0b, 0x5c, 0x4d, 0xb8, 0x81, 0x7d, 0xf9, 0x2d, 0xf0, 0x02, 0x15, 0xae}
+	// PartitionTypeMicrosoftBasicData is ebd0a0a2-b9e5-4433-87c0-68b6b72699c7.
+	PartitionTypeMicrosoftBasicData = UUID{0xeb, 0xd0, 0xa0, 0xa2, 0xb9, 0xe5, 0x44, 0x33, 0x87, 0xc0, 0x68, 0xb6, 0xb7, 0x26, 0x99, 0xc7}
+	// PartitionTypeWindowsRecovery is de94bba4-06d1-4d40-a16a-bfd50179d6ac.
+	PartitionTypeWindowsRecovery = UUID{0xde, 0x94, 0xbb, 0xa4, 0x06, 0xd1, 0x4d, 0x40, 0xa1, 0x6a, 0xbf, 0xd5, 0x01, 0x79, 0xd6, 0xac}
+	// PartitionTypeLinuxFilesystem is 0fc63daf-8483-4772-8e79-3d69d8477de4.
+	PartitionTypeLinuxFilesystem = UUID{0x0f, 0xc6, 0x3d, 0xaf, 0x84, 0x83, 0x47, 0x72, 0x8e, 0x79, 0x3d, 0x69, 0xd8, 0x47, 0x7d, 0xe4}
+	// PartitionTypeLinuxSwap is 0657fd6d-a4ab-43c4-84e5-0933c84b4f4f.
+	PartitionTypeLinuxSwap = UUID{0x06, 0x57, 0xfd, 0x6d, 0xa4, 0xab, 0x43, 0xc4, 0x84, 0xe5, 0x09, 0x33, 0xc8, 0x4b, 0x4f, 0x4f}
+	// PartitionTypeLinuxLVM is e6d6d379-f507-44c2-a23c-238f2a3df928.
+	PartitionTypeLinuxLVM = UUID{0xe6, 0xd6, 0xd3, 0x79, 0xf5, 0x07, 0x44, 0xc2, 0xa2, 0x3c, 0x23, 0x8f, 0x2a, 0x3d, 0xf9, 0x28}
+	// PartitionTypeLinuxRAID is a19d880f-05fc-4d3b-a006-743f0f84911e.
+	PartitionTypeLinuxRAID = UUID{0xa1, 0x9d, 0x88, 0x0f, 0x05, 0xfc, 0x4d, 0x3b, 0xa0, 0x06, 0x74, 0x3f, 0x0f, 0x84, 0x91, 0x1e}
+	// PartitionTypeLinuxHome is 933ac7e1-2eb4-4f13-b844-0e14e2aef915.
+	PartitionTypeLinuxHome = UUID{0x93, 0x3a, 0xc7, 0xe1, 0x2e, 0xb4, 0x4f, 0x13, 0xb8, 0x44, 0x0e, 0x14, 0xe2, 0xae, 0xf9, 0x15}
+	// PartitionTypeLinuxRootX86_64 is 4f68bce3-e8cd-4db1-96e7-fbcaf984b709.
+	PartitionTypeLinuxRootX86_64 = UUID{0x4f, 0x68, 0xbc, 0xe3, 0xe8, 0xcd, 0x4d, 0xb1, 0x96, 0xe7, 0xfb, 0xca, 0xf9, 0x84, 0xb7, 0x09}
+	// PartitionTypeAppleHFSPlus is 48465300-0000-11aa-aa11-00306543ecac.
+	PartitionTypeAppleHFSPlus = UUID{0x48, 0x46, 0x53, 0x00, 0x00, 0x00, 0x11, 0xaa, 0xaa, 0x11, 0x00, 0x30, 0x65, 0x43, 0xec, 0xac}
+	// PartitionTypeAppleAPFS is 7c3457ef-0000-11aa-aa11-00306543ecac.
+	PartitionTypeAppleAPFS = UUID{0x7c, 0x34, 0x57, 0xef, 0x00, 0x00, 0x11, 0xaa, 0xaa, 0x11, 0x00, 0x30, 0x65, 0x43, 0xec, 0xac}
+	// PartitionTypeFreeBSDBoot is 83bd6b9d-7f41-11dc-be0b-001560b84f0f.
+	PartitionTypeFreeBSDBoot = UUID{0x83, 0xbd, 0x6b, 0x9d, 0x7f, 0x41, 0x11, 0xdc, 0xbe, 0x0b, 0x00, 0x15, 0x60, 0xb8, 0x4f, 0x0f}
+	// PartitionTypeFreeBSDZFS is 516e7cba-6ecf-11d6-8ff8-00022d09712b.
+	PartitionTypeFreeBSDZFS = UUID{0x51, 0x6e, 0x7c, 0xba, 0x6e, 0xcf, 0x11, 0xd6, 0x8f, 0xf8, 0x00, 0x02, 0x2d, 0x09, 0x71, 0x2b}
+	// PartitionTypeSolarisUsrAppleZFS is 6a898cc3-1dd2-11b2-99a6-080020736631.
+	PartitionTypeSolarisUsrAppleZFS = UUID{0x6a, 0x89, 0x8c, 0xc3, 0x1d, 0xd2, 0x11, 0xb2, 0x99, 0xa6, 0x08, 0x00, 0x20, 0x73, 0x66, 0x31}
+	// PartitionTypeChromeOSKernel is fe3a2a5d-4f32-41a7-b725-accc3285a309.
+	PartitionTypeChromeOSKernel = UUID{0xfe, 0x3a, 0x2a, 0x5d, 0x4f, 0x32, 0x41, 0xa7, 0xb7, 0x25, 0xac, 0xcc, 0x32, 0x85, 0xa3, 0x09}
+	// PartitionTypeChromeOSRootfs is 3cb8e202-3b7e-47dd-8a3c-7ff2a13cfcec.
+	PartitionTypeChromeOSRootfs = UUID{0x3c, 0xb8, 0xe2, 0x02, 0x3b, 0x7e, 0x47, 0xdd, 0x8a, 0x3c, 0x7f, 0xf2, 0xa1, 0x3c, 0xfc, 0xec}
+	// PartitionTypeMicrosoftLDMMeta is 5808c8aa-7e8f-42e0-85d2-e1e90434cfb3.
+	PartitionTypeMicrosoftLDMMeta = UUID{0x58, 0x08, 0xc8, 0xaa, 0x7e, 0x8f, 0x42, 0xe0, 0x85, 0xd2, 0xe1, 0xe9, 0x04, 0x34, 0xcf, 0xb3}
+	// PartitionTypeMicrosoftLDMData is af9b60a0-1431-4f62-bc68-3311714a69ad.
+	PartitionTypeMicrosoftLDMData = UUID{0xaf, 0x9b, 0x60, 0xa0, 0x14, 0x31, 0x4f, 0x62, 0xbc, 0x68, 0x33, 0x11, 0x71, 0x4a, 0x69, 0xad}
+	// PartitionTypeMicrosoftStorageSpaces is e75caf8f-f680-4cee-afa3-b001e56efc2d.
+	PartitionTypeMicrosoftStorageSpaces = UUID{0xe7, 0x5c, 0xaf, 0x8f, 0xf6, 0x80, 0x4c, 0xee, 0xaf, 0xa3, 0xb0, 0x01, 0xe5, 0x6e, 0xfc, 0x2d}
+)
+
+var partitionTypeNames = map[UUID]string{
+	PartitionTypeUnused:                 "Unused",
+	PartitionTypeEFISystem:              "EFI System",
+	PartitionTypeBIOSBoot:               "BIOS boot",
+	PartitionTypeMicrosoftReserved:      "Microsoft reserved",
+	PartitionTypeMicrosoftBasicData:     "Microsoft basic data",
+	PartitionTypeWindowsRecovery:        "Windows recovery environment",
+	PartitionTypeLinuxFilesystem:        "Linux filesystem",
+	PartitionTypeLinuxSwap:              "Linux swap",
+	PartitionTypeLinuxLVM:               "Linux LVM",
+	PartitionTypeLinuxRAID:              "Linux RAID",
+	PartitionTypeLinuxHome:              "Linux /home",
+	PartitionTypeLinuxRootX86_64:        "Linux root (x86-64)",
+	PartitionTypeAppleHFSPlus:           "Apple HFS+",
+	PartitionTypeAppleAPFS:              "Apple APFS",
+	PartitionTypeFreeBSDBoot:            "FreeBSD boot",
+	PartitionTypeFreeBSDZFS:             "FreeBSD ZFS",
+	PartitionTypeSolarisUsrAppleZFS:     "Solaris /usr & Apple ZFS",
+	PartitionTypeChromeOSKernel:         "ChromeOS kernel",
+	PartitionTypeChromeOSRootfs:         "ChromeOS rootfs",
+	PartitionTypeMicrosoftLDMMeta:       "Microsoft LDM metadata",
+	PartitionTypeMicrosoftLDMData:       "Microsoft LDM data",
+	PartitionTypeMicrosoftStorageSpaces: "Microsoft Storage Spaces",
+}
+
+// PartitionTypeName returns the name of a well-known GPT partition type GUID,
+// and a boolean indicating if the partition type is known.
+func PartitionTypeName(u UUID) (string, bool) {
+	name, ok := partitionTypeNames[u]
+	return name, ok
+}
+
+// FromGUIDBytes returns the UUID represented by the provided GUID bytes in the
+// mixed-endian layout used by GPT headers and Microsoft's GUID structure, where
+// the first three fields are stored little-endian.
+func FromGUIDBytes(b [16]byte) UUID {
+	return UUID(swapGUIDBytes(b))
+}
+
+// GUIDBytes returns the UUID in the mixed-endian layout used by GPT headers
+// and Microsoft's GUID structure, where the first three fields are stored
+// little-endian. It is the inverse of FromGUIDBytes.
+func (u UUID) GUIDBytes() [16]byte {
+	return swapGUIDBytes(u)
+}
+
+// swapGUIDBytes reverses the byte order of the first three fields of b.
+func swapGUIDBytes(b [16]byte) [16]byte {
+	b[0], b[1], b[2], b[3] = b[3], b[2], b[1], b[0]
+	b[4], b[5] = b[5], b[4]
+	b[6], b[7] = b[7], b[6]
+	return b
+}
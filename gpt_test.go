@@ -0,0 +1,32 @@
+package uuid
+
+import "testing"
+
+func TestGUIDBytes(t *testing.T) {
+	disk := [16]byte{0x28, 0x73, 0x2a, 0xc1, 0x1f, 0xf8, 0xd2, 0x11, 0xba, 0x4b, 0x00, 0xa0, 0xc9, 0x3e, 0xc9, 0x3b}
+	u := FromGUIDBytes(disk)
+	if u != PartitionTypeEFISystem {
+		t.Fatalf("Unexpected UUID from GUID bytes: %s", u)
+	}
+	if u.GUIDBytes() != disk {
+		t.Fatalf("Unexpected GUID bytes: %x", u.GUIDBytes())
+	}
+
+	u = newUUID()
+	if FromGUIDBytes(u.GUIDBytes()) != u {
+		t.Fatalf("GUID bytes did not round trip: %s", u)
+	}
+}
+
+func TestPartitionTypeName(t *testing.T) {
+	name, ok := PartitionTypeName(PartitionTypeEFISystem)
+	if !ok || name != "EFI System" {
+		t.Fatalf("Unexpected partition type name: %s, %t", name, ok)
+	}
+	if len(partitionTypeNames) != 22 {
+		t.Fatalf("Unexpected number of partition types: %d", len(partitionTypeNames))
+	}
+	if _, ok = PartitionTypeName(newUUID()); ok {
+		t.Fatal("Unexpected partition type name for a V4 UUID")
+	}
+}
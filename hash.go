@@ -0,0 +1,74 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"hash/maphash"
+)
+
+// Hash64 returns a well-mixed 64-bit hash of the UUID. It is equivalent to
+// calling Hash64Seed with a seed of zero.
+//
+// The result is stable across processes and releases, making it suitable for
+// reducing UUIDs to 64 bits for use in e.g. metric labels or sharding keys.
+func (u UUID) Hash64() uint64 {
+	return u.Hash64Seed(0)
+}
+
+// Hash64Seed returns a well-mixed 64-bit hash of the UUID using the provided
+// seed. Different seeds produce independent hashes of the same UUID.
+//
+// The result is stable across processes and releases: both halves of the
+// UUID are combined with the seed and passed through the MurmurHash3 64-bit
+// finalizer.
+func (u UUID) Hash64Seed(seed uint64) uint64 {
+	hi := binary.BigEndian.Uint64(u[:8])
+	lo := binary.BigEndian.Uint64(u[8:])
+	h := fmix64(seed ^ 0x9e3779b97f4a7c15 ^ hi)
+	return fmix64(h ^ lo)
+}
+
+// Hash returns a 64-bit hash of the UUID using hash/maphash with the provided
+// seed, suitable for use in hash tables, consistent-hash rings, and sketches.
+//
+// Unlike Hash64, the result is only stable for a given Seed within one
+// process, as maphash seeds cannot be shared between processes.
+func (u UUID) Hash(seed maphash.Seed) uint64 {
+	return maphash.Bytes(seed, u[:])
+}
+
+// Hash32 returns a 32-bit hash of the UUID using hash/maphash with the
+// provided seed, folding the 64-bit result of Hash.
+func (u UUID) Hash32(seed maphash.Seed) uint32 {
+	h := u.Hash(seed)
+	return uint32(h ^ h>>32)
+}
+
+// ShortString returns the first n lowercase hexadecimal characters of the
+// UUID's Hash64. Values of n are clamped to the range [0, 16].
+//
+// Because the characters are taken from the hash rather than the UUID itself,
+// short strings of time-ordered UUIDs (e.g. V7) do not share a common prefix.
+func (u UUID) ShortString(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	if n > 16 {
+		n = 16
+	}
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], u.Hash64())
+	var buf [16]byte
+	hex.Encode(buf[:], b[:])
+	return string(buf[:n])
+}
+
+// fmix64 is the 64-bit finalizer from MurmurHash3.
+func fmix64(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
@@ -0,0 +1,84 @@
+package uuid
+
+import (
+	"hash/maphash"
+	"testing"
+)
+
+func TestHash64(t *testing.T) {
+	u := Must(ParseString("9e754ef6-8dd9-4903-af43-7aea99bfb1fe"))
+
+	h := u.Hash64()
+	if h != u.Hash64Seed(0) {
+		t.Fatalf("Unexpected Hash64 mismatch with zero seed: %x", h)
+	}
+	if h == u.Hash64Seed(1) {
+		t.Fatalf("Unexpected equal hash for different seeds: %x", h)
+	}
+	if h == (UUID{}).Hash64() {
+		t.Fatalf("Unexpected equal hash for different UUIDs: %x", h)
+	}
+
+	// Hashes must be stable across processes and releases.
+	const expected = 0xdf02679628338404
+	if h != expected {
+		t.Fatalf("Unexpected hash: %#x", h)
+	}
+}
+
+func TestShortString(t *testing.T) {
+	u := Must(ParseString("9e754ef6-8dd9-4903-af43-7aea99bfb1fe"))
+
+	full := u.ShortString(16)
+	if full != "df02679628338404" {
+		t.Fatalf("Unexpected short string: %s", full)
+	}
+	var table = []struct {
+		n   int
+		exp string
+	}{
+		{-1, ""},
+		{0, ""},
+		{1, full[:1]},
+		{8, full[:8]},
+		{16, full},
+		{32, full},
+	}
+	for i := 0; i < len(table); i++ {
+		if s := u.ShortString(table[i].n); s != table[i].exp {
+			t.Fatalf("Unexpected short string for %d: %s", table[i].n, s)
+		}
+	}
+}
+
+func TestHash(t *testing.T) {
+	u1, u2 := newUUID(), newUUID()
+	seed := maphash.MakeSeed()
+
+	if u1.Hash(seed) != u1.Hash(seed) {
+		t.Fatal("Unexpected different hashes with the same seed")
+	}
+	if u1.Hash(seed) == u2.Hash(seed) {
+		t.Fatalf("Unexpected equal hashes for %s and %s", u1, u2)
+	}
+	if u1.Hash(seed) != maphash.Bytes(seed, u1[:]) {
+		t.Fatal("Unexpected hash mismatch with maphash.Bytes")
+	}
+	h := u1.Hash(seed)
+	if u1.Hash32(seed) != uint32(h^h>>32) {
+		t.Fatalf("Unexpected 32-bit hash: %x", u1.Hash32(seed))
+	}
+
+	allocs := testing.AllocsPerRun(100, func() { _ = u1.Hash32(seed) })
+	if allocs != 0 {
+		t.Fatalf("Unexpected allocations in Hash32: %v", allocs)
+	}
+}
+
+func BenchmarkHash(b *testing.B) {
+	u := newUUID()
+	seed := maphash.MakeSeed()
+	for i := 0; i < b.N; i++ {
+		_ = u.Hash(seed)
+	}
+}
@@ -0,0 +1,70 @@
+package uuid
+
+import "time"
+
+//go:generate go run gen_wrappers.go
+
+// ID is a UUID tagged with an entity type T, providing compile-time safety
+// against mixing up the IDs of different entities. For example, an
+// ID[User] cannot be assigned to a variable of type ID[Order].
+//
+// The type parameter is only used as a marker; all methods delegate to the
+// underlying UUID. As with the other wrapper types of UUID, the methods shared
+// with UUID are generated into wrappers_gen.go.
+type ID[T any] UUID
+
+// FromUUID returns the provided UUID as an ID for the entity type T.
+func FromUUID[T any](u UUID) ID[T] {
+	return ID[T](u)
+}
+
+// ParseID parses the provided UUID string using the same rules as Parse,
+// returning it as an ID for the entity type T.
+func ParseID[T any](s string) (ID[T], error) {
+	u, err := ParseString(s)
+	return ID[T](u), err
+}
+
+// UUID returns the ID as a plain UUID.
+func (id ID[T]) UUID() UUID {
+	return UUID(id)
+}
+
+// Format returns the hexadecimal format of the ID as an array of 36 bytes.
+func (id ID[T]) Format() [36]byte {
+	return UUID(id).Format()
+}
+
+// Bytes returns the hexadecimal format of the ID as a slice of 36 bytes.
+func (id ID[T]) Bytes() []byte {
+	return UUID(id).Bytes()
+}
+
+// Version returns the version number of the ID, as specified in RFC 4122.
+func (id ID[T]) Version() int {
+	return UUID(id).Version()
+}
+
+// Time returns the embedded timestamp of the ID, and a boolean indicating if a
+// timestamp was successfully parsed. See UUID.Time for more information.
+func (id ID[T]) Time() (time.Time, bool) {
+	return UUID(id).Time()
+}
+
+// UnmarshalParam reads the textual UUID param into the ID. See
+// UUID.UnmarshalParam for more information.
+func (id *ID[T]) UnmarshalParam(param string) error {
+	return (*UUID)(id).UnmarshalParam(param)
+}
+
+// MarshalCSV returns the CSV cell representation of the ID. See
+// UUID.MarshalCSV for more information.
+func (id ID[T]) MarshalCSV() (string, error) {
+	return UUID(id).MarshalCSV()
+}
+
+// UnmarshalCSV reads the ID from the CSV cell s. See UUID.UnmarshalCSV for
+// more information.
+func (id *ID[T]) UnmarshalCSV(s string) error {
+	return (*UUID)(id).UnmarshalCSV(s)
+}
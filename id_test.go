@@ -0,0 +1,32 @@
+package uuid
+
+import "testing"
+
+type testUser struct{}
+
+func TestID(t *testing.T) {
+	u := newUUID()
+	id := FromUUID[testUser](u)
+	if id.UUID() != u {
+		t.Fatalf("Unexpected UUID from ID: %s", id.UUID())
+	}
+	if id.String() != u.String() {
+		t.Fatalf("Unexpected ID string: %s", id.String())
+	}
+
+	parsed, err := ParseID[testUser](u.String())
+	if err != nil {
+		t.Fatalf("Unexpected parsing error: %s", err.Error())
+	}
+	if parsed != id {
+		t.Fatalf("Invalid parsed ID: %s", parsed)
+	}
+
+	var bound ID[testUser]
+	if err = bound.UnmarshalParam(u.String()); err != nil {
+		t.Fatalf("Unexpected param unmarshaling error: %s", err.Error())
+	}
+	if bound != id {
+		t.Fatalf("Unexpected param unmarshaling result: %s", bound)
+	}
+}
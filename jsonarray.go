@@ -0,0 +1,45 @@
+package uuid
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// DecodeJSONArray decodes a JSON array of UUID strings from dec, calling fn
+// with the index and parsed UUID of each element as it is read. Elements are
+// parsed directly into UUIDs, without first decoding the array into a slice
+// of strings. A JSON null is treated as an empty array.
+//
+// If an element cannot be parsed, an *IndexError wrapping ErrInvalidUUID is
+// returned. If fn returns an error, decoding stops and the error is returned.
+func DecodeJSONArray(dec *json.Decoder, fn func(i int, u UUID) error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if tok != json.Delim('[') {
+		return errors.New("uuid: expected json array")
+	}
+
+	// The raw message is reused, as RawMessage appends into its existing
+	// buffer when decoding.
+	var raw json.RawMessage
+	for i := 0; dec.More(); i++ {
+		if err = dec.Decode(&raw); err != nil {
+			return err
+		}
+		var u UUID
+		if err = u.UnmarshalJSON(raw); err != nil {
+			return &IndexError{Index: i, Value: string(raw), Err: err}
+		}
+		if err = fn(i, u); err != nil {
+			return err
+		}
+	}
+
+	_, err = dec.Token()
+	return err
+}
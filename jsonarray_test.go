@@ -0,0 +1,69 @@
+package uuid
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestDecodeJSONArray(t *testing.T) {
+	ids := []UUID{newUUID(), newUUID(), newUUID()}
+	b, err := json.Marshal(ids)
+	if err != nil {
+		t.Fatalf("Unexpected json marshaling error: %s", err.Error())
+	}
+
+	var out []UUID
+	err = DecodeJSONArray(json.NewDecoder(strings.NewReader(string(b))), func(i int, u UUID) error {
+		if i != len(out) {
+			t.Fatalf("Unexpected index: %d", i)
+		}
+		out = append(out, u)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected decoding error: %s", err.Error())
+	}
+	if len(out) != len(ids) || out[0] != ids[0] || out[1] != ids[1] || out[2] != ids[2] {
+		t.Fatalf("Unexpected decoded UUIDs: %v", out)
+	}
+
+	for _, s := range []string{"[]", "null"} {
+		err = DecodeJSONArray(json.NewDecoder(strings.NewReader(s)), func(int, UUID) error {
+			t.Fatalf("Unexpected element for %s", s)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Unexpected decoding error for %s: %s", s, err.Error())
+		}
+	}
+}
+
+func TestDecodeJSONArrayErrors(t *testing.T) {
+	noop := func(int, UUID) error { return nil }
+
+	in := `["` + newUUID().String() + `", 42]`
+	err := DecodeJSONArray(json.NewDecoder(strings.NewReader(in)), noop)
+	var ie *IndexError
+	if !errors.As(err, &ie) || ie.Index != 1 || ie.Value != "42" || !errors.Is(err, ErrInvalidUUID) {
+		t.Fatalf("Unexpected decoding error: %v", err)
+	}
+
+	for _, s := range []string{"", `{"a":1}`, `"` + newUUID().String() + `"`, `["` + newUUID().String() + `"`} {
+		if err = DecodeJSONArray(json.NewDecoder(strings.NewReader(s)), noop); err == nil {
+			t.Fatalf("Unexpected decoding pass: %s", s)
+		}
+	}
+
+	errStop := errors.New("stop")
+	in = `["` + newUUID().String() + `", "` + newUUID().String() + `"]`
+	var count int
+	err = DecodeJSONArray(json.NewDecoder(strings.NewReader(in)), func(int, UUID) error {
+		count++
+		return errStop
+	})
+	if err != errStop || count != 1 {
+		t.Fatalf("Unexpected callback error result: %v, %d", err, count)
+	}
+}
@@ -0,0 +1,29 @@
+package uuid
+
+import "sync"
+
+// LazyString wraps a UUID, caching its string representation the first time
+// String is called. It is safe for concurrent use, and must not be copied
+// after first use.
+type LazyString struct {
+	u    UUID
+	once sync.Once
+	s    string
+}
+
+// NewLazyString returns a new LazyString wrapping the provided UUID.
+func NewLazyString(u UUID) *LazyString {
+	return &LazyString{u: u}
+}
+
+// UUID returns the wrapped UUID.
+func (l *LazyString) UUID() UUID {
+	return l.u
+}
+
+// String returns the human-readable, hexadecimal format of the wrapped UUID,
+// formatting it only on the first call.
+func (l *LazyString) String() string {
+	l.once.Do(func() { l.s = l.u.String() })
+	return l.s
+}
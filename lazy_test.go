@@ -0,0 +1,42 @@
+package uuid
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+var _ fmt.Stringer = (*LazyString)(nil)
+
+func TestLazyString(t *testing.T) {
+	u := newUUID()
+	l := NewLazyString(u)
+	if l.UUID() != u {
+		t.Fatalf("Unexpected wrapped UUID: %s", l.UUID())
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if s := l.String(); s != u.String() {
+				t.Errorf("Unexpected string: %s", s)
+			}
+		}()
+	}
+	wg.Wait()
+
+	allocs := testing.AllocsPerRun(100, func() { _ = l.String() })
+	if allocs != 0 {
+		t.Fatalf("Unexpected allocations in cached String: %v", allocs)
+	}
+}
+
+func BenchmarkLazyString(b *testing.B) {
+	l := NewLazyString(Must(NewV4()))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = l.String()
+	}
+}
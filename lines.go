@@ -0,0 +1,58 @@
+package uuid
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ParseLines parses newline-delimited UUIDs from r, returning all of the
+// parsed UUIDs or the first error encountered. See ParseLinesFunc for more
+// information.
+func ParseLines(r io.Reader) ([]UUID, error) {
+	var out []UUID
+	err := ParseLinesFunc(r, func(_ int, u UUID) error {
+		out = append(out, u)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ParseLinesFunc parses newline-delimited UUIDs from r, calling fn with the
+// 1-based line number and parsed UUID for each line. Each line must contain a
+// 32 or 36-byte textual UUID, after removing any surrounding whitespace, and
+// blank lines are skipped. Lines are parsed directly from the read buffer
+// without being copied.
+//
+// If a line cannot be parsed, an error wrapping ErrInvalidUUID that includes
+// the line number is returned. If fn returns an error, parsing stops and the
+// error is returned.
+func ParseLinesFunc(r io.Reader, fn func(line int, u UUID) error) error {
+	br := bufio.NewReader(r)
+	for n := 1; ; n++ {
+		line, err := br.ReadSlice('\n')
+		if err != nil && err != io.EOF {
+			if errors.Is(err, bufio.ErrBufferFull) {
+				return fmt.Errorf("uuid: line %d: %w", n, ErrInvalidUUID)
+			}
+			return err
+		}
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			u, perr := parseText(line)
+			if perr != nil {
+				return fmt.Errorf("uuid: line %d: %w", n, perr)
+			}
+			if ferr := fn(n, u); ferr != nil {
+				return ferr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package uuid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseLines(t *testing.T) {
+	u1, u2, u3 := newUUID(), newUUID(), newUUID()
+	input := u1.String() + "\n" + u2.String() + "\r\n\n  " + u3.String()
+	ids, err := ParseLines(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Unexpected parsing error: %s", err.Error())
+	}
+	if len(ids) != 3 || ids[0] != u1 || ids[1] != u2 || ids[2] != u3 {
+		t.Fatalf("Unexpected parsed UUIDs: %v", ids)
+	}
+
+	ids, err = ParseLines(strings.NewReader(""))
+	if err != nil || len(ids) != 0 {
+		t.Fatalf("Unexpected result for empty input: %v, %v", ids, err)
+	}
+
+	_, err = ParseLines(strings.NewReader(u1.String() + "\nbad\n"))
+	if !errors.Is(err, ErrInvalidUUID) {
+		t.Fatalf("Unexpected parsing error: %v", err)
+	}
+	if err.Error() != "uuid: line 2: uuid: invalid uuid provided" {
+		t.Fatalf("Unexpected parsing error message: %s", err.Error())
+	}
+
+	// Lines must not be parsed as raw 16-byte UUIDs.
+	_, err = ParseLines(strings.NewReader(u1.String() + "\nabcdefghijklmnop\n"))
+	if !errors.Is(err, ErrInvalidUUID) {
+		t.Fatalf("Unexpected parsing error for 16-byte line: %v", err)
+	}
+
+	_, err = ParseLines(strings.NewReader(strings.Repeat("a", 5000)))
+	if !errors.Is(err, ErrInvalidUUID) {
+		t.Fatalf("Unexpected parsing error for long line: %v", err)
+	}
+}
+
+func TestParseLinesFunc(t *testing.T) {
+	input := newUUID().String() + "\n\n" + newUUID().String() + "\n"
+	var lines []int
+	err := ParseLinesFunc(strings.NewReader(input), func(line int, _ UUID) error {
+		lines = append(lines, line)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected parsing error: %s", err.Error())
+	}
+	if len(lines) != 2 || lines[0] != 1 || lines[1] != 3 {
+		t.Fatalf("Unexpected line numbers: %v", lines)
+	}
+
+	errStop := errors.New("stop")
+	err = ParseLinesFunc(strings.NewReader(input), func(int, UUID) error { return errStop })
+	if err != errStop {
+		t.Fatalf("Unexpected error from callback: %v", err)
+	}
+}
+
+func BenchmarkParseLinesFunc(b *testing.B) {
+	var sb strings.Builder
+	for i := 0; i < 1000; i++ {
+		sb.WriteString(Must(NewV4()).String())
+		sb.WriteByte('\n')
+	}
+	input := sb.String()
+	b.SetBytes(int64(len(input)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = ParseLinesFunc(strings.NewReader(input), func(int, UUID) error { return nil })
+	}
+}
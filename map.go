@@ -0,0 +1,131 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"sync"
+)
+
+// mapShardBits is the number of hash bits used to select the shard of a key.
+const mapShardBits = 6
+
+// mapShards is the number of shards in a Map.
+const mapShards = 1 << mapShardBits
+
+// Map is a concurrent map keyed by UUID, safe for use by multiple goroutines.
+// Keys are spread across independently locked shards to reduce contention
+// under high read concurrency.
+//
+// The zero value is an empty map ready to use. A Map must not be copied after
+// first use.
+type Map[V any] struct {
+	shards [mapShards]mapShard[V]
+}
+
+type mapShard[V any] struct {
+	mu sync.RWMutex
+	m  map[UUID]V
+	// Pad each shard to avoid false sharing between adjacent locks.
+	_ [64]byte
+}
+
+// shard returns the shard responsible for the provided key. Both halves of
+// the UUID are mixed so that time-ordered UUIDs (e.g. V7) are evenly spread.
+func (m *Map[V]) shard(key UUID) *mapShard[V] {
+	h := binary.BigEndian.Uint64(key[:8]) ^ binary.BigEndian.Uint64(key[8:])
+	h *= 0x9e3779b97f4a7c15
+	return &m.shards[h>>(64-mapShardBits)]
+}
+
+// Load returns the value stored in the map for the key, and whether a value
+// was present.
+func (m *Map[V]) Load(key UUID) (V, bool) {
+	s := m.shard(key)
+	s.mu.RLock()
+	v, ok := s.m[key]
+	s.mu.RUnlock()
+	return v, ok
+}
+
+// Store sets the value for the key.
+func (m *Map[V]) Store(key UUID, value V) {
+	s := m.shard(key)
+	s.mu.Lock()
+	if s.m == nil {
+		s.m = make(map[UUID]V)
+	}
+	s.m[key] = value
+	s.mu.Unlock()
+}
+
+// LoadOrStore returns the existing value for the key if present. Otherwise,
+// it stores and returns the given value. The loaded result is true if the
+// value was loaded, false if stored.
+func (m *Map[V]) LoadOrStore(key UUID, value V) (V, bool) {
+	s := m.shard(key)
+	s.mu.RLock()
+	v, ok := s.m[key]
+	s.mu.RUnlock()
+	if ok {
+		return v, true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok = s.m[key]; ok {
+		return v, true
+	}
+	if s.m == nil {
+		s.m = make(map[UUID]V)
+	}
+	s.m[key] = value
+	return value, false
+}
+
+// Delete deletes the value for the key.
+func (m *Map[V]) Delete(key UUID) {
+	s := m.shard(key)
+	s.mu.Lock()
+	delete(s.m, key)
+	s.mu.Unlock()
+}
+
+// Len returns the number of entries in the map.
+func (m *Map[V]) Len() int {
+	var n int
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		n += len(s.m)
+		s.mu.RUnlock()
+	}
+	return n
+}
+
+// Range calls fn sequentially for each key and value in the map. If fn
+// returns false, Range stops the iteration.
+//
+// Each shard is snapshotted before fn is called, so fn may safely call other
+// methods on the Map. Range does not correspond to a consistent snapshot of
+// the whole map.
+func (m *Map[V]) Range(fn func(key UUID, value V) bool) {
+	type entry struct {
+		key   UUID
+		value V
+	}
+	var entries []entry
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		entries = entries[:0]
+		for k, v := range s.m {
+			entries = append(entries, entry{k, v})
+		}
+		s.mu.RUnlock()
+
+		for _, e := range entries {
+			if !fn(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package uuid
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	var m Map[int]
+
+	ids := make([]UUID, 1000)
+	for i := range ids {
+		ids[i] = newUUID()
+		m.Store(ids[i], i)
+	}
+	if n := m.Len(); n != len(ids) {
+		t.Fatalf("Unexpected length: %d", n)
+	}
+
+	for i, id := range ids {
+		v, ok := m.Load(id)
+		if !ok || v != i {
+			t.Fatalf("Unexpected value for %s: %d, %t", id, v, ok)
+		}
+	}
+	if _, ok := m.Load(UUID{}); ok {
+		t.Fatal("Unexpected value for nil UUID")
+	}
+
+	if v, loaded := m.LoadOrStore(ids[0], -1); !loaded || v != 0 {
+		t.Fatalf("Unexpected LoadOrStore result: %d, %t", v, loaded)
+	}
+	if v, loaded := m.LoadOrStore(UUID{}, -1); loaded || v != -1 {
+		t.Fatalf("Unexpected LoadOrStore result: %d, %t", v, loaded)
+	}
+	m.Delete(UUID{})
+
+	seen := make(map[UUID]bool)
+	m.Range(func(key UUID, value int) bool {
+		if ids[value] != key {
+			t.Fatalf("Unexpected range entry: %s, %d", key, value)
+		}
+		seen[key] = true
+		return true
+	})
+	if len(seen) != len(ids) {
+		t.Fatalf("Unexpected number of range entries: %d", len(seen))
+	}
+
+	var count int
+	m.Range(func(key UUID, value int) bool {
+		m.Delete(key)
+		count++
+		return count < 10
+	})
+	if n := m.Len(); count != 10 || n != len(ids)-10 {
+		t.Fatalf("Unexpected length after deleting: %d, %d", count, n)
+	}
+}
+
+func TestMapConcurrent(t *testing.T) {
+	var m Map[int]
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				id := newUUID()
+				m.Store(id, j)
+				if v, ok := m.Load(id); !ok || v != j {
+					t.Errorf("Unexpected value: %d, %t", v, ok)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if n := m.Len(); n != 8000 {
+		t.Fatalf("Unexpected length: %d", n)
+	}
+}
+
+func BenchmarkMapLoad(b *testing.B) {
+	var m Map[int]
+	ids := make([]UUID, 1024)
+	for i := range ids {
+		ids[i] = newUUID()
+		m.Store(ids[i], i)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var i int
+		for pb.Next() {
+			m.Load(ids[i&1023])
+			i++
+		}
+	})
+}
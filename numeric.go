@@ -0,0 +1,125 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"math/bits"
+	"strconv"
+)
+
+// maxDecimalLen is the length of the largest 128-bit unsigned decimal.
+const maxDecimalLen = 39
+
+// FromInt64s returns the UUID made up of the big-endian 128-bit integer whose
+// upper and lower 64 bits are hi and lo, as stored by schemas that split a
+// UUID into a pair of BIGINT columns.
+func FromInt64s(hi, lo int64) UUID {
+	var u UUID
+	binary.BigEndian.PutUint64(u[:8], uint64(hi))
+	binary.BigEndian.PutUint64(u[8:], uint64(lo))
+	return u
+}
+
+// Int64s returns the upper and lower 64 bits of the UUID, interpreted as a
+// big-endian 128-bit integer. It is the inverse of FromInt64s.
+func (u UUID) Int64s() (hi, lo int64) {
+	return int64(binary.BigEndian.Uint64(u[:8])), int64(binary.BigEndian.Uint64(u[8:]))
+}
+
+// FromBigEndian returns the UUID represented by the provided big-endian
+// unsigned integer bytes, which may be shorter than 16 bytes if leading zeros
+// have been removed. ErrInvalidUUID is returned if b is longer than 16 bytes.
+func FromBigEndian(b []byte) (UUID, error) {
+	var u UUID
+	if len(b) > len(u) {
+		return u, ErrInvalidUUID
+	}
+	copy(u[len(u)-len(b):], b)
+	return u, nil
+}
+
+// ParseDecimal parses the provided string as a 128-bit unsigned decimal
+// integer, as stored in DECIMAL(39) or NUMERIC columns, returning the UUID or
+// any error encountered.
+func ParseDecimal(s string) (UUID, error) {
+	if len(s) == 0 || len(s) > maxDecimalLen {
+		return UUID{}, ErrInvalidUUID
+	}
+	var hi, lo uint64
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < '0' || c > '9' {
+			return UUID{}, ErrInvalidUUID
+		}
+		// Calculate (hi, lo) * 10 + c, checking for overflow.
+		ofl, hi10 := bits.Mul64(hi, 10)
+		lohi, lo10 := bits.Mul64(lo, 10)
+		var carry uint64
+		lo, carry = bits.Add64(lo10, uint64(c-'0'), 0)
+		hi, carry = bits.Add64(hi10, lohi, carry)
+		if ofl != 0 || carry != 0 {
+			return UUID{}, ErrInvalidUUID
+		}
+	}
+	return FromInt64s(int64(hi), int64(lo)), nil
+}
+
+// Decimal returns the UUID formatted as a 128-bit unsigned decimal integer.
+// It is the inverse of ParseDecimal.
+func (u UUID) Decimal() string {
+	hi := binary.BigEndian.Uint64(u[:8])
+	lo := binary.BigEndian.Uint64(u[8:])
+	if hi == 0 {
+		return strconv.FormatUint(lo, 10)
+	}
+	var buf [maxDecimalLen]byte
+	i := len(buf)
+	for hi != 0 || lo != 0 {
+		var r uint64
+		hi, r = bits.Div64(0, hi, 10)
+		lo, r = bits.Div64(r, lo, 10)
+		i--
+		buf[i] = byte('0' + r)
+	}
+	return string(buf[i:])
+}
+
+// DecimalUUID is a UUID that is stored in a database as a 128-bit unsigned
+// decimal integer, such as in a DECIMAL(39) or NUMERIC column.
+type DecimalUUID UUID
+
+// Value implements the sql driver Valuer interface. It returns the decimal
+// string representation of the UUID, or nil if the UUID is the zero UUID.
+func (d DecimalUUID) Value() (driver.Value, error) {
+	if UUID(d).IsZero() {
+		return nil, nil
+	}
+	return UUID(d).Decimal(), nil
+}
+
+// Scan implements the sql Scanner interface. It reads a UUID from a decimal
+// string or byte slice, or a non-negative int64, into d. A nil src is read as
+// the zero UUID.
+func (d *DecimalUUID) Scan(src interface{}) error {
+	var id UUID
+	var err error
+	switch v := src.(type) {
+	case nil:
+	case []byte:
+		id, err = ParseDecimal(string(v))
+	case string:
+		id, err = ParseDecimal(v)
+	case int64:
+		if v < 0 {
+			err = ErrInvalidUUID
+		}
+		id = FromInt64s(0, v)
+	default:
+		err = ErrInvalidUUID
+	}
+	if err != nil {
+		return err
+	}
+	*d = DecimalUUID(id)
+	return nil
+}
@@ -0,0 +1,106 @@
+package uuid
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestInt64s(t *testing.T) {
+	u := newUUID()
+	hi, lo := u.Int64s()
+	if FromInt64s(hi, lo) != u {
+		t.Fatalf("Unexpected UUID from int64 pair: %s", FromInt64s(hi, lo))
+	}
+
+	u = FromInt64s(-1, 1)
+	if s := u.String(); s != "ffffffff-ffff-ffff-0000-000000000001" {
+		t.Fatalf("Unexpected UUID from int64 pair: %s", s)
+	}
+}
+
+func TestFromBigEndian(t *testing.T) {
+	u, err := FromBigEndian([]byte{0x01, 0x02})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if s := u.String(); s != "00000000-0000-0000-0000-000000000102" {
+		t.Fatalf("Unexpected UUID from big-endian bytes: %s", s)
+	}
+
+	if _, err = FromBigEndian(make([]byte, 17)); err != ErrInvalidUUID {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		u := newUUID()
+		exp := new(big.Int).SetBytes(u[:]).String()
+		s := u.Decimal()
+		if s != exp {
+			t.Fatalf("Unexpected decimal: %s (expected %s)", s, exp)
+		}
+		p, err := ParseDecimal(s)
+		if err != nil {
+			t.Fatalf("Unexpected parsing error: %s", err.Error())
+		}
+		if p != u {
+			t.Fatalf("Invalid parsed UUID: %s", p)
+		}
+	}
+
+	if s := (UUID{}).Decimal(); s != "0" {
+		t.Fatalf("Unexpected decimal for zero UUID: %s", s)
+	}
+	max := UUID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	if s := max.Decimal(); s != "340282366920938463463374607431768211455" {
+		t.Fatalf("Unexpected decimal for max UUID: %s", s)
+	}
+}
+
+func TestParseDecimalInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"-1",
+		"12a",
+		"340282366920938463463374607431768211456",
+		"1000000000000000000000000000000000000000",
+	} {
+		if _, err := ParseDecimal(s); err != ErrInvalidUUID {
+			t.Fatalf("Unexpected parsing pass: %s", s)
+		}
+	}
+}
+
+func TestDecimalUUID(t *testing.T) {
+	u := newUUID()
+	v, err := DecimalUUID(u).Value()
+	if err != nil {
+		t.Fatalf("Unexpected value error: %s", err.Error())
+	}
+	if v.(string) != u.Decimal() {
+		t.Fatalf("Unexpected value result: %v", v)
+	}
+
+	var d DecimalUUID
+	for _, src := range []interface{}{u.Decimal(), []byte(u.Decimal())} {
+		if err = d.Scan(src); err != nil {
+			t.Fatalf("Unexpected scan error: %s", err.Error())
+		}
+		if UUID(d) != u {
+			t.Fatalf("Unexpected scan result: %s", UUID(d))
+		}
+	}
+	if err = d.Scan(int64(258)); err != nil {
+		t.Fatalf("Unexpected scan error: %s", err.Error())
+	}
+	if UUID(d) != FromInt64s(0, 258) {
+		t.Fatalf("Unexpected scan result: %s", UUID(d))
+	}
+	if err = d.Scan(int64(-1)); err != ErrInvalidUUID {
+		t.Fatalf("Unexpected scan error: %v", err)
+	}
+	if err = d.Scan(nil); err != nil || !UUID(d).IsZero() {
+		t.Fatalf("Unexpected scan result for nil: %s, %v", UUID(d), err)
+	}
+}
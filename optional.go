@@ -0,0 +1,100 @@
+package uuid
+
+// OptionalUUID is a UUID that may be absent when decoded, such as an optional
+// ID in an environment variable, config file, or a wire format that omits
+// unset bytes fields, or a nullable database column. Unlike UUID, empty text,
+// binary data, database values, or path and query params are read as the zero
+// UUID, and the zero UUID is written as empty text or binary data.
+type OptionalUUID UUID
+
+// MarshalText implements the TextMarshaler interface. It returns the 36 byte
+// hexadecimal representation of the UUID, or empty text if the UUID is the
+// zero UUID.
+func (o OptionalUUID) MarshalText() ([]byte, error) {
+	if o.IsZero() {
+		return []byte{}, nil
+	}
+	return UUID(o).MarshalText()
+}
+
+// UnmarshalText implements the TextUnmarshaler interface. It reads the text
+// UUID from text into o, reading empty text as the zero UUID.
+func (o *OptionalUUID) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = OptionalUUID{}
+		return nil
+	}
+	return (*UUID)(o).UnmarshalText(text)
+}
+
+// MarshalJSON implements the json Marshaler interface. It returns the JSON
+// string of the UUID, or an empty JSON string if the UUID is the zero UUID.
+func (o OptionalUUID) MarshalJSON() ([]byte, error) {
+	if o.IsZero() {
+		return []byte(`""`), nil
+	}
+	return UUID(o).MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface. It reads the JSON
+// string UUID from data into o, reading an empty string as the zero UUID. As
+// with encoding/json, null leaves o unchanged.
+func (o *OptionalUUID) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "null":
+		return nil
+	case `""`:
+		*o = OptionalUUID{}
+		return nil
+	}
+	return (*UUID)(o).UnmarshalJSON(data)
+}
+
+// MarshalBinary implements the BinaryMarshaler interface. It returns the 16
+// byte binary representation of the UUID, or empty data if the UUID is the
+// zero UUID.
+func (o OptionalUUID) MarshalBinary() ([]byte, error) {
+	if o.IsZero() {
+		return []byte{}, nil
+	}
+	return UUID(o).MarshalBinary()
+}
+
+// UnmarshalBinary implements the BinaryUnmarshaler interface. It reads the
+// binary UUID from data into o, reading empty data as the zero UUID.
+func (o *OptionalUUID) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*o = OptionalUUID{}
+		return nil
+	}
+	return (*UUID)(o).UnmarshalBinary(data)
+}
+
+// UnmarshalParam reads the textual UUID param into o, reading an empty param
+// as the zero UUID. See UUID.UnmarshalParam for more information.
+func (o *OptionalUUID) UnmarshalParam(param string) error {
+	if param == "" {
+		*o = OptionalUUID{}
+		return nil
+	}
+	return (*UUID)(o).UnmarshalParam(param)
+}
+
+// Scan implements the sql Scanner interface. It reads the UUID from src into
+// o, accepting the same values as UUID.Scan. A nil src (SQL NULL) or an empty
+// string or byte slice is read as the zero UUID.
+func (o *OptionalUUID) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		if v == "" {
+			*o = OptionalUUID{}
+			return nil
+		}
+	case []byte:
+		if len(v) == 0 {
+			*o = OptionalUUID{}
+			return nil
+		}
+	}
+	return (*UUID)(o).Scan(src)
+}
@@ -0,0 +1,147 @@
+package uuid
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestOptionalUUIDText(t *testing.T) {
+	u := newUUID()
+	var o OptionalUUID
+	if err := o.UnmarshalText(u.Bytes()); err != nil {
+		t.Fatalf("Unexpected text unmarshaling error: %s", err.Error())
+	}
+	if UUID(o) != u {
+		t.Fatalf("Unexpected text unmarshaling result: %s", o)
+	}
+	if b, _ := o.MarshalText(); string(b) != u.String() {
+		t.Fatalf("Unexpected text marshaling result: %s", b)
+	}
+
+	if err := o.UnmarshalText([]byte{}); err != nil {
+		t.Fatalf("Unexpected text unmarshaling error: %s", err.Error())
+	}
+	if !o.IsZero() {
+		t.Fatalf("Expected UUID to be zero from empty text, got: %s", o)
+	}
+	if b, _ := o.MarshalText(); len(b) != 0 {
+		t.Fatalf("Unexpected text marshaling result for zero UUID: %s", b)
+	}
+
+	if err := o.UnmarshalText([]byte("invalid")); err != ErrInvalidUUID {
+		t.Fatalf("Unexpected text unmarshaling error: %v", err)
+	}
+}
+
+func TestOptionalUUIDJSON(t *testing.T) {
+	type config struct {
+		ID OptionalUUID
+	}
+	var c config
+	if err := json.Unmarshal([]byte(`{"ID":""}`), &c); err != nil {
+		t.Fatalf("Unexpected json unmarshaling error: %s", err.Error())
+	}
+	if !c.ID.IsZero() {
+		t.Fatalf("Expected UUID to be zero from empty string, got: %s", c.ID)
+	}
+	if b, _ := json.Marshal(c); string(b) != `{"ID":""}` {
+		t.Fatalf("Unexpected json marshaling result for zero UUID: %s", b)
+	}
+
+	u := newUUID()
+	b, err := json.Marshal(config{ID: OptionalUUID(u)})
+	if err != nil {
+		t.Fatalf("Unexpected json marshaling error: %s", err.Error())
+	}
+	if string(b) != `{"ID":"`+u.String()+`"}` {
+		t.Fatalf("Unexpected json marshaling result: %s", b)
+	}
+	if err = json.Unmarshal(b, &c); err != nil || UUID(c.ID) != u {
+		t.Fatalf("Unexpected json unmarshaling result: %s, %v", c.ID, err)
+	}
+	if err = json.Unmarshal([]byte(`{"ID":null}`), &c); err != nil || UUID(c.ID) != u {
+		t.Fatalf("Unexpected json unmarshaling result for null: %s, %v", c.ID, err)
+	}
+}
+
+func TestOptionalUUIDBinary(t *testing.T) {
+	u := newUUID()
+	var o OptionalUUID
+	if err := o.UnmarshalBinary(u[:]); err != nil {
+		t.Fatalf("Unexpected binary unmarshaling error: %s", err.Error())
+	}
+	if UUID(o) != u {
+		t.Fatalf("Unexpected binary unmarshaling result: %s", o)
+	}
+	if b, _ := o.MarshalBinary(); !bytes.Equal(b, u[:]) {
+		t.Fatalf("Unexpected binary marshaling result: %x", b)
+	}
+
+	if err := o.UnmarshalBinary(nil); err != nil {
+		t.Fatalf("Unexpected binary unmarshaling error: %s", err.Error())
+	}
+	if !o.IsZero() {
+		t.Fatalf("Expected UUID to be zero from empty data, got: %s", o)
+	}
+	if b, _ := o.MarshalBinary(); len(b) != 0 {
+		t.Fatalf("Unexpected binary marshaling result for zero UUID: %x", b)
+	}
+
+	if err := o.UnmarshalBinary([]byte{0}); err != ErrInvalidUUID {
+		t.Fatalf("Unexpected binary unmarshaling error: %v", err)
+	}
+}
+
+func TestOptionalUUIDParam(t *testing.T) {
+	u := newUUID()
+	var o OptionalUUID
+	if err := o.UnmarshalParam("{" + u.String() + "}"); err != nil {
+		t.Fatalf("Unexpected param unmarshaling error: %s", err.Error())
+	}
+	if UUID(o) != u {
+		t.Fatalf("Unexpected param unmarshaling result: %s", o)
+	}
+	if err := o.UnmarshalParam(""); err != nil {
+		t.Fatalf("Unexpected param unmarshaling error: %s", err.Error())
+	}
+	if !o.IsZero() {
+		t.Fatalf("Expected UUID to be zero from empty param, got: %s", o)
+	}
+	if err := o.UnmarshalParam("invalid"); err != ErrInvalidUUID {
+		t.Fatalf("Unexpected param unmarshaling error: %v", err)
+	}
+}
+
+func TestOptionalUUIDSQL(t *testing.T) {
+	u := newUUID()
+	v, err := OptionalUUID(u).Value()
+	if err != nil {
+		t.Fatalf("Unexpected value error: %s", err.Error())
+	}
+	if v != u.String() {
+		t.Fatalf("Unexpected value result: %v", v)
+	}
+	if v, _ = (OptionalUUID{}).Value(); v != nil {
+		t.Fatalf("Unexpected value result for zero UUID: %v", v)
+	}
+
+	var table = []interface{}{nil, "", []byte{}}
+	for i := 0; i < len(table); i++ {
+		o := OptionalUUID(u)
+		if err = o.Scan(table[i]); err != nil {
+			t.Fatalf("Unexpected scan error for %#v: %s", table[i], err.Error())
+		}
+		if !o.IsZero() {
+			t.Fatalf("Expected UUID to be zero from %#v, got: %s", table[i], o)
+		}
+	}
+
+	var o OptionalUUID
+	if err = o.Scan(u.String()); err != nil || UUID(o) != u {
+		t.Fatalf("Unexpected scan result: %s, %v", o, err)
+	}
+	if err = o.Scan("invalid"); err != ErrInvalidUUID {
+		t.Fatalf("Unexpected scan error: %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"io"
+	"sync"
+	"time"
+)
+
+// subMillisBits is the number of bits used for sub-millisecond precision,
+// filling the 12-bit "rand_a" field of a V7 UUID.
+const subMillisBits = 12
+
+// maxJitter is the largest bound accepted by WithJitter, ensuring that the
+// 2*max+1 possible offsets fit within the 62 random bits used to choose one.
+const maxJitter time.Duration = 1<<61 - 1
+
+// V7Option configures the generation of a V7 UUID with NewV7Opts.
+type V7Option func(*v7Options)
+
+type v7Options struct {
+	subMillis   bool
+	counterBits uint
+	jitter      time.Duration
+	r           io.Reader
+}
+
+// WithSubMillisecondPrecision uses the 12 bits following the millisecond
+// timestamp to store the sub-millisecond fraction of the timestamp, as
+// described in RFC 9562, section 6.2, method 3. This improves the ordering of
+// UUIDs generated within the same millisecond.
+func WithSubMillisecondPrecision() V7Option {
+	return func(o *v7Options) { o.subMillis = true }
+}
+
+// WithCounter uses a fixed bit-length dedicated counter of the provided width,
+// ensuring UUIDs generated with the same options are strictly increasing. See
+// V7Generator for more information. When combined with
+// WithSubMillisecondPrecision, the counter follows the sub-millisecond bits.
+//
+// The counter state is shared by all calls to NewV7Opts using the same
+// counter width and precision, and counter overflows are handled with
+// RolloverIncrementTime. It panics if width is not in the range
+// [MinCounterBits, MaxCounterBits].
+func WithCounter(width int) V7Option {
+	checkCounterBits(width)
+	return func(o *v7Options) { o.counterBits = uint(width) }
+}
+
+// WithJitter adds a uniformly distributed random offset in the range
+// [-max, max] to the timestamp before it is embedded in the UUID. This hides
+// the exact creation time of the UUID while retaining approximate ordering.
+// If max <= 0, no jitter is added, and bounds greater than about 73 years are
+// reduced to that limit.
+//
+// UUIDs generated less than 2*max apart may not be sorted in the order they
+// were generated, so the bound should be kept small relative to the ordering
+// guarantees required. When combined with WithCounter, UUIDs remain strictly
+// increasing, but negative offsets are partially absorbed by the counter,
+// hiding less of the creation time.
+func WithJitter(max time.Duration) V7Option {
+	if max > maxJitter {
+		max = maxJitter
+	}
+	return func(o *v7Options) { o.jitter = max }
+}
+
+// WithRand reads random bytes from r instead of using the default Generator.
+func WithRand(r io.Reader) V7Option {
+	return func(o *v7Options) { o.r = r }
+}
+
+// random fills u with random bytes, read either from the configured
+// io.Reader or generated as a V4 UUID by the default Generator. The random
+// bits of a V4 UUID cover all of the bits of a V7 UUID that are not
+// overwritten by NewV7Opts.
+func (o *v7Options) random(u *UUID) error {
+	if o.r != nil {
+		_, err := io.ReadFull(o.r, u[:])
+		return err
+	}
+	var err error
+	*u, err = Default().NewV4()
+	return err
+}
+
+// v7Counters holds the shared counter state used by NewV7Opts, indexed by
+// whether sub-millisecond precision is used and the counter width.
+var v7Counters [2][MaxCounterBits + 1]struct {
+	mu sync.Mutex
+	c  v7Counter
+}
+
+// NewV7Opts uses the provided timestamp and options to generate and return a
+// new V7 UUID, as per RFC 9562. Without any options, it is equivalent to
+// NewV7.
+func NewV7Opts(now time.Time, opts ...V7Option) (UUID, error) {
+	var o v7Options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.jitter > 0 {
+		var b UUID
+		if err := o.random(&b); err != nil {
+			return UUID{}, err
+		}
+		// Use the final 62 bits, which are random for both a V4 UUID and
+		// the bytes read from an io.Reader.
+		v := binary.BigEndian.Uint64(b[8:]) & (1<<62 - 1)
+		now = now.Add(time.Duration(v%uint64(2*o.jitter+1)) - o.jitter)
+	}
+
+	var u UUID
+	ms := now.UnixMilli()
+	if ms < 0 || ms > maxV7Millis {
+		return u, ErrTimeOutOfRange
+	}
+
+	err := o.random(&u)
+	if err != nil {
+		return UUID{}, err
+	}
+
+	// The timestamp is handled in ticks of the configured precision.
+	tick, maxTick := ms, int64(maxV7Millis)
+	var offset uint
+	if o.subMillis {
+		frac := int64(now.Nanosecond()%1e6) << subMillisBits / 1e6
+		tick, maxTick = ms<<subMillisBits|frac, maxV7Millis<<subMillisBits|(1<<subMillisBits-1)
+		offset = subMillisBits
+	}
+
+	if o.counterBits > 0 {
+		var idx int
+		if o.subMillis {
+			idx = 1
+		}
+		state := &v7Counters[idx][o.counterBits]
+		state.mu.Lock()
+		var c uint32
+		tick, c, err = state.c.next(tick, maxTick, getBits(&u, offset, o.counterBits), o.counterBits, RolloverIncrementTime)
+		state.mu.Unlock()
+		if err != nil {
+			return UUID{}, err
+		}
+		setBits(&u, offset, o.counterBits, c)
+	}
+
+	if o.subMillis {
+		setMillis(&u, tick>>subMillisBits)
+		setBits(&u, 0, subMillisBits, uint32(tick))
+	} else {
+		setMillis(&u, tick)
+	}
+	setVersion(&u, 7)
+	setVariant(&u)
+	return u, nil
+}
@@ -0,0 +1,125 @@
+package uuid
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+func TestNewV7Opts(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	u, err := NewV7Opts(now)
+	if err != nil {
+		t.Fatalf("Unexpected error generating uuid v7: %s", err.Error())
+	}
+	verifyVariant(t, u)
+	verifyVersion(t, u, 7)
+	if ut, _ := u.Time(); !ut.Equal(now) {
+		t.Fatalf("Time not equal to original: %v vs %v", now, ut)
+	}
+
+	if _, err = NewV7Opts(time.UnixMilli(-1)); err != ErrTimeOutOfRange {
+		t.Fatalf("Unexpected error for time before epoch: %v", err)
+	}
+}
+
+func TestNewV7OptsSubMillisecondPrecision(t *testing.T) {
+	base := time.UnixMilli(time.Now().UnixMilli())
+	var prev UUID
+	for i := 0; i < 1000; i++ {
+		now := base.Add(time.Duration(i) * time.Microsecond)
+		u := Must(NewV7Opts(now, WithSubMillisecondPrecision(), WithRand(rand.Reader)))
+		verifyVariant(t, u)
+		verifyVersion(t, u, 7)
+		if ut, _ := u.Time(); !ut.Equal(now.Truncate(time.Millisecond)) {
+			t.Fatalf("Unexpected time: %v", ut)
+		}
+		if exp := uint32(i) * 4096 / 1000; getBits(&u, 0, subMillisBits) != exp {
+			t.Fatalf("Unexpected sub-millisecond bits for %d: %d", i, getBits(&u, 0, subMillisBits))
+		}
+		if i > 0 && bytes.Compare(prev[6:8], u[6:8]) > 0 {
+			t.Fatalf("UUIDs not ordered: %s vs %s", prev, u)
+		}
+		prev = u
+	}
+}
+
+func TestNewV7OptsCounter(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	for _, opts := range [][]V7Option{
+		{WithCounter(MinCounterBits)},
+		{WithCounter(MaxCounterBits)},
+		{WithCounter(MaxCounterBits), WithSubMillisecondPrecision()},
+	} {
+		prev := Must(NewV7Opts(now, opts...))
+		for i := 0; i < 5000; i++ {
+			u := Must(NewV7Opts(now, opts...))
+			verifyVariant(t, u)
+			verifyVersion(t, u, 7)
+			if bytes.Compare(prev[:], u[:]) >= 0 {
+				t.Fatalf("UUIDs not increasing: %s vs %s", prev, u)
+			}
+			prev = u
+		}
+	}
+}
+
+func TestNewV7OptsJitter(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	var min, max time.Time
+	for i := 0; i < 1000; i++ {
+		u := Must(NewV7Opts(now, WithJitter(500*time.Millisecond)))
+		verifyVariant(t, u)
+		verifyVersion(t, u, 7)
+		ut, _ := u.Time()
+		if ut.Before(now.Add(-500*time.Millisecond)) || ut.After(now.Add(500*time.Millisecond)) {
+			t.Fatalf("Time outside of jitter bound: %v vs %v", ut, now)
+		}
+		if i == 0 || ut.Before(min) {
+			min = ut
+		}
+		if i == 0 || ut.After(max) {
+			max = ut
+		}
+	}
+	if !min.Before(now) || !max.After(now) {
+		t.Fatalf("Expected jitter in both directions: %v, %v", min, max)
+	}
+
+	u := Must(NewV7Opts(now, WithJitter(0)))
+	if ut, _ := u.Time(); !ut.Equal(now) {
+		t.Fatalf("Unexpected time with no jitter: %v", ut)
+	}
+}
+
+func TestNewV7OptsJitterLarge(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	// The largest possible offset is chosen when the 62 random bits equal
+	// 2*max, which must not overflow for very large bounds.
+	b := make([]byte, 32)
+	binary.BigEndian.PutUint64(b[8:], uint64(2*maxJitter))
+	u, err := NewV7Opts(now, WithJitter(1<<62), WithRand(bytes.NewReader(b)))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	ut, _ := u.Time()
+	if exp := now.Add(maxJitter); ut.UnixMilli() != exp.UnixMilli() {
+		t.Fatalf("Unexpected time with large jitter: %v, expected %v", ut, exp)
+	}
+}
+
+func TestSetBits(t *testing.T) {
+	u := Must(NewV4())
+	orig := u
+	setBits(&u, 10, 20, 0xabcde)
+	if v := getBits(&u, 10, 20); v != 0xabcde {
+		t.Fatalf("Unexpected bits: %x", v)
+	}
+	if getBits(&u, 0, 10) != getBits(&orig, 0, 10) || getBits(&u, 30, 26) != getBits(&orig, 30, 26) {
+		t.Fatalf("Unexpected change to surrounding bits: %s vs %s", orig, u)
+	}
+	verifyVariant(t, u)
+	verifyVersion(t, u, 4)
+}
@@ -0,0 +1,122 @@
+package uuid
+
+import (
+	"io"
+	"time"
+)
+
+// orderedTick is the resolution of the timestamp in an ordered UUID.
+const orderedTick = 10 * time.Microsecond
+
+// maxOrderedTicks is the largest timestamp that fits in the 48 bits reserved
+// for it in an ordered UUID.
+const maxOrderedTicks = 1<<48 - 1
+
+// NewOrdered returns a new timestamp-first ordered UUID, as generated by
+// Ramsey's TimestampFirstCombCodec in PHP (and Laravel's Str::orderedUuid),
+// using the default Generator as a source of randomness.
+//
+// An ordered UUID is a V4 UUID with its first 48 bits replaced by the number
+// of 10 microsecond intervals since the Unix epoch, so that ordered UUIDs sort
+// by creation time. The timestamp must be representable in 48 bits (before
+// 2059), otherwise ErrTimeOutOfRange is returned.
+//
+// New applications should prefer V7 UUIDs; see OrderedToV7.
+func NewOrdered(now time.Time) (UUID, error) {
+	ticks, ok := orderedTicks(now)
+	if !ok {
+		return UUID{}, ErrTimeOutOfRange
+	}
+	u, err := Default().NewV4()
+	if err != nil {
+		return u, err
+	}
+	setOrderedTicks(&u, ticks)
+	return u, nil
+}
+
+// NewOrderedFromRand uses the provided timestamp and random io.Reader to
+// return a new timestamp-first ordered UUID. See NewOrdered for more
+// information.
+func NewOrderedFromRand(now time.Time, r io.Reader) (UUID, error) {
+	var u UUID
+	ticks, ok := orderedTicks(now)
+	if !ok {
+		return u, ErrTimeOutOfRange
+	}
+	if _, err := io.ReadFull(r, u[6:]); err != nil {
+		return u, err
+	}
+	setOrderedTicks(&u, ticks)
+	setVersion(&u, 4)
+	setVariant(&u)
+	return u, nil
+}
+
+func orderedTicks(now time.Time) (int64, bool) {
+	ticks := now.UnixMicro() / int64(orderedTick/time.Microsecond)
+	return ticks, ticks >= 0 && ticks <= maxOrderedTicks
+}
+
+func setOrderedTicks(u *UUID, ticks int64) {
+	u[0] = byte(ticks >> 40)
+	u[1] = byte(ticks >> 32)
+	u[2] = byte(ticks >> 24)
+	u[3] = byte(ticks >> 16)
+	u[4] = byte(ticks >> 8)
+	u[5] = byte(ticks)
+}
+
+// OrderedTime returns the timestamp embedded in a timestamp-first ordered
+// UUID. The boolean is false if the UUID is not an RFC 4122 V4 UUID.
+//
+// As ordered UUIDs are indistinguishable from V4 UUIDs, OrderedTime returns
+// a (meaningless) timestamp for any V4 UUID; see IsOrdered.
+func OrderedTime(u UUID) (time.Time, bool) {
+	if u.Version() != 4 || u[8]&0xc0 != 0x80 {
+		return time.Time{}, false
+	}
+	ticks := int64(u[0])<<40 | int64(u[1])<<32 | int64(u[2])<<24 |
+		int64(u[3])<<16 | int64(u[4])<<8 | int64(u[5])
+	return time.UnixMicro(ticks * int64(orderedTick/time.Microsecond)), true
+}
+
+// IsOrdered reports whether u appears to be a timestamp-first ordered UUID
+// created at or after since, and no later than one hour in the future.
+//
+// IsOrdered is a heuristic: a random V4 UUID has a chance of embedding a
+// plausible timestamp proportional to the length of the window, roughly 1.1%
+// per year between since and now.
+func IsOrdered(u UUID, since time.Time) bool {
+	t, ok := OrderedTime(u)
+	return ok && !t.Before(since) && !t.After(time.Now().Add(time.Hour))
+}
+
+// SwapCOMB converts between a timestamp-first ordered UUID and the COMB layout
+// used by Ramsey's CombGenerator without the timestamp-first codec, in which
+// the timestamp occupies the last 48 bits, by swapping the first and last 48
+// bits of the UUID.
+func SwapCOMB(u UUID) UUID {
+	var out UUID
+	copy(out[:6], u[10:])
+	copy(out[6:10], u[6:10])
+	copy(out[10:], u[:6])
+	return out
+}
+
+// OrderedToV7 returns the V7 UUID equivalent of a timestamp-first ordered
+// UUID, embedding the same timestamp truncated to milliseconds and retaining
+// the remaining bits of u, so that the conversion is deterministic. The
+// boolean is false if u is not an RFC 4122 V4 UUID.
+//
+// The order of ordered UUIDs created within the same millisecond is not
+// preserved.
+func OrderedToV7(u UUID) (UUID, bool) {
+	t, ok := OrderedTime(u)
+	if !ok {
+		return UUID{}, false
+	}
+	setMillis(&u, t.UnixMilli())
+	setVersion(&u, 7)
+	return u, true
+}
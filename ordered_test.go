@@ -0,0 +1,102 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestNewOrdered(t *testing.T) {
+	now := time.UnixMicro(1714667854893000)
+	u, err := NewOrdered(now)
+	if err != nil {
+		t.Fatalf("Unexpected ordered error: %s", err.Error())
+	}
+	verifyVersion(t, u, 4)
+	verifyVariant(t, u)
+	if s := u.String(); s[:13] != "9bf2b8d7-0194" {
+		t.Fatalf("Unexpected ordered UUID: %s", s)
+	}
+	if ut, ok := OrderedTime(u); !ok || !ut.Equal(now) {
+		t.Fatalf("Unexpected ordered time: %v, %t", ut, ok)
+	}
+
+	later, err := NewOrdered(now.Add(10 * time.Microsecond))
+	if err != nil {
+		t.Fatalf("Unexpected ordered error: %s", err.Error())
+	}
+	if later.String() <= u.String() {
+		t.Fatalf("Ordered UUIDs not increasing: %s vs %s", u, later)
+	}
+
+	for _, ts := range []time.Time{time.UnixMicro(-10), time.Date(2060, 1, 1, 0, 0, 0, 0, time.UTC)} {
+		if _, err = NewOrdered(ts); err != ErrTimeOutOfRange {
+			t.Fatalf("Unexpected error for %v: %v", ts, err)
+		}
+	}
+}
+
+func TestNewOrderedFromRand(t *testing.T) {
+	now := time.UnixMicro(1714667854893000)
+	u, err := NewOrderedFromRand(now, bytes.NewReader(bytes.Repeat([]byte{0xff}, 10)))
+	if err != nil {
+		t.Fatalf("Unexpected ordered error: %s", err.Error())
+	}
+	if s := u.String(); s != "9bf2b8d7-0194-4fff-bfff-ffffffffffff" {
+		t.Fatalf("Unexpected ordered UUID: %s", s)
+	}
+	if _, err = NewOrderedFromRand(now, bytes.NewReader(nil)); err == nil {
+		t.Fatal("Unexpected pass with empty reader")
+	}
+}
+
+func TestIsOrdered(t *testing.T) {
+	since := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	u := Must(NewOrdered(time.Now()))
+	if !IsOrdered(u, since) {
+		t.Fatalf("Expected ordered UUID to be detected: %s", u)
+	}
+	old := Must(NewOrdered(since.Add(-time.Second)))
+	if IsOrdered(old, since) {
+		t.Fatalf("Unexpected ordered UUID detected before since: %s", old)
+	}
+	v7 := Must(NewV7(time.Now()))
+	if IsOrdered(v7, since) {
+		t.Fatalf("Unexpected V7 UUID detected as ordered: %s", v7)
+	}
+}
+
+func TestSwapCOMB(t *testing.T) {
+	u := Must(ParseString("9bf2b8d7-0194-4fff-bfff-0123456789ab"))
+	comb := SwapCOMB(u)
+	if s := comb.String(); s != "01234567-89ab-4fff-bfff-9bf2b8d70194" {
+		t.Fatalf("Unexpected COMB UUID: %s", s)
+	}
+	if SwapCOMB(comb) != u {
+		t.Fatalf("Unexpected round-tripped UUID: %s", SwapCOMB(comb))
+	}
+}
+
+func TestOrderedToV7(t *testing.T) {
+	now := time.UnixMicro(1714667854893450)
+	u := Must(NewOrdered(now))
+	v7, ok := OrderedToV7(u)
+	if !ok {
+		t.Fatal("Unexpected failure converting ordered UUID")
+	}
+	verifyVersion(t, v7, 7)
+	verifyVariant(t, v7)
+	if vt, _ := v7.Time(); !vt.Equal(now.Truncate(time.Millisecond)) {
+		t.Fatalf("Unexpected V7 time: %v", vt)
+	}
+	if !bytes.Equal(v7[7:], u[7:]) {
+		t.Fatalf("Unexpected V7 random bits: %s vs %s", v7, u)
+	}
+	if again, _ := OrderedToV7(u); again != v7 {
+		t.Fatalf("Unexpected non-deterministic conversion: %s vs %s", again, v7)
+	}
+
+	if _, ok = OrderedToV7(Must(NewV7(now))); ok {
+		t.Fatal("Unexpected conversion of V7 UUID")
+	}
+}
@@ -0,0 +1,51 @@
+package uuid
+
+import "encoding/binary"
+
+// Rand62 returns the random bits of a V4 or V7 UUID, with the version and
+// variant bits removed. The 62 bits following the variant field are returned
+// in lo, and the random bits preceding the variant field are returned in hi:
+//
+//   - V4: hi holds the 60 bits surrounding the version field.
+//   - V7: hi holds the 12-bit rand_a field, following the timestamp.
+//
+// Zeros are returned for all other versions.
+func (u UUID) Rand62() (hi, lo uint64) {
+	lo = binary.BigEndian.Uint64(u[8:]) & (1<<62 - 1)
+	rand12 := uint64(u[6]&0x0f)<<8 | uint64(u[7])
+	switch u.Version() {
+	case 4:
+		hi = uint64(u[0])<<52 | uint64(u[1])<<44 | uint64(u[2])<<36 |
+			uint64(u[3])<<28 | uint64(u[4])<<20 | uint64(u[5])<<12 | rand12
+	case 7:
+		hi = rand12
+	default:
+		return 0, 0
+	}
+	return hi, lo
+}
+
+// RandomBits returns the random bits of a V4 or V7 UUID as a big-endian
+// integer, with the version and variant bits removed. The 122 random bits of
+// a V4 UUID are returned in 16 bytes, and the 74 random bits of a V7 UUID are
+// returned in 10 bytes; unused leading bits are zero.
+//
+// RandomBits returns nil for all other versions.
+func (u UUID) RandomBits() []byte {
+	var n int
+	switch u.Version() {
+	case 4:
+		n = 16
+	case 7:
+		n = 10
+	default:
+		return nil
+	}
+	hi, lo := u.Rand62()
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], hi>>2)
+	binary.BigEndian.PutUint64(buf[8:], hi<<62|lo)
+	out := make([]byte, n)
+	copy(out, buf[16-n:])
+	return out
+}
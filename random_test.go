@@ -0,0 +1,56 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestRand62(t *testing.T) {
+	all := bytes.Repeat([]byte{0xff}, 16)
+
+	var u UUID
+	copy(u[:], all)
+	u.SetVersion(4)
+	u.SetVariant()
+	hi, lo := u.Rand62()
+	if hi != 1<<60-1 || lo != 1<<62-1 {
+		t.Fatalf("Unexpected V4 random bits: %x, %x", hi, lo)
+	}
+	exp := append([]byte{0x03}, all[1:]...)
+	if b := u.RandomBits(); !bytes.Equal(b, exp) {
+		t.Fatalf("Unexpected V4 random bytes: %x", b)
+	}
+
+	u.SetVersion(7)
+	hi, lo = u.Rand62()
+	if hi != 1<<12-1 || lo != 1<<62-1 {
+		t.Fatalf("Unexpected V7 random bits: %x, %x", hi, lo)
+	}
+	exp = append([]byte{0x03}, all[7:]...)
+	if b := u.RandomBits(); !bytes.Equal(b, exp) {
+		t.Fatalf("Unexpected V7 random bytes: %x", b)
+	}
+
+	u.SetVersion(5)
+	if hi, lo = u.Rand62(); hi != 0 || lo != 0 {
+		t.Fatalf("Unexpected V5 random bits: %x, %x", hi, lo)
+	}
+	if b := u.RandomBits(); b != nil {
+		t.Fatalf("Unexpected V5 random bytes: %x", b)
+	}
+}
+
+func TestRandomBitsV7(t *testing.T) {
+	u, err := NewV7(time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected V7 error: %s", err.Error())
+	}
+	b := u.RandomBits()
+	if len(b) != 10 || b[0]&0xfc != 0 {
+		t.Fatalf("Unexpected random bytes: %x", b)
+	}
+	if b[0]&0x03 != u[6]>>2&0x03 || b[9] != u[15] {
+		t.Fatalf("Unexpected random bytes for %s: %x", u, b)
+	}
+}
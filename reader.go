@@ -0,0 +1,69 @@
+package uuid
+
+// Reader is an io.Reader that produces a stream of UUIDs from a generator
+// function, either as consecutive 16-byte binary UUIDs, or as 36-byte
+// formatted UUIDs each followed by a newline.
+type Reader struct {
+	gen  func() (UUID, error)
+	text bool
+	buf  [37]byte
+	off  int
+	n    int
+}
+
+// NewReader returns a new Reader that produces consecutive 16-byte binary
+// UUIDs using gen. If gen is nil, NewV4 is used.
+func NewReader(gen func() (UUID, error)) *Reader {
+	return newReader(gen, false)
+}
+
+// NewTextReader returns a new Reader that produces newline-terminated, 36-byte
+// formatted UUIDs using gen. If gen is nil, NewV4 is used.
+func NewTextReader(gen func() (UUID, error)) *Reader {
+	return newReader(gen, true)
+}
+
+func newReader(gen func() (UUID, error), text bool) *Reader {
+	if gen == nil {
+		gen = NewV4
+	}
+	return &Reader{gen: gen, text: text}
+}
+
+// Read implements the io.Reader interface, filling p with UUIDs. Partially
+// read UUIDs are continued on the next call to Read. If an error occurs while
+// generating a UUID, the number of bytes read so far is returned along with
+// the error.
+func (r *Reader) Read(p []byte) (int, error) {
+	var total int
+	for len(p) > 0 {
+		if r.off == r.n {
+			if err := r.next(); err != nil {
+				return total, err
+			}
+		}
+		n := copy(p, r.buf[r.off:r.n])
+		r.off += n
+		total += n
+		p = p[n:]
+	}
+	return total, nil
+}
+
+// next fills the buffer with the next generated UUID.
+func (r *Reader) next() error {
+	u, err := r.gen()
+	if err != nil {
+		return err
+	}
+	if r.text {
+		u.format(r.buf[:36])
+		r.buf[36] = '\n'
+		r.n = 37
+	} else {
+		copy(r.buf[:], u[:])
+		r.n = 16
+	}
+	r.off = 0
+	return nil
+}
@@ -0,0 +1,65 @@
+package uuid
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+var _ io.Reader = (*Reader)(nil)
+
+func TestReader(t *testing.T) {
+	var generated []UUID
+	gen := func() (UUID, error) {
+		u := newUUID()
+		generated = append(generated, u)
+		return u, nil
+	}
+
+	r := NewReader(gen)
+	buf := make([]byte, 40)
+	for i := 0; i < 2; i++ {
+		n, err := r.Read(buf[i*20 : (i+1)*20])
+		if err != nil || n != 20 {
+			t.Fatalf("Unexpected read result: %d, %v", n, err)
+		}
+	}
+	if len(generated) != 3 {
+		t.Fatalf("Unexpected number of generated UUIDs: %d", len(generated))
+	}
+	for i := 0; i < 2; i++ {
+		if UUID(buf[i*16:(i+1)*16]) != generated[i] {
+			t.Fatalf("Unexpected UUID at index %d: %x", i, buf[i*16:(i+1)*16])
+		}
+	}
+	if !bytes.Equal(buf[32:40], generated[2][:8]) {
+		t.Fatalf("Unexpected partial UUID: %x", buf[32:40])
+	}
+
+	errGen := errors.New("gen error")
+	r = NewReader(func() (UUID, error) { return UUID{}, errGen })
+	if n, err := r.Read(buf); n != 0 || err != errGen {
+		t.Fatalf("Unexpected read result: %d, %v", n, err)
+	}
+}
+
+func TestTextReader(t *testing.T) {
+	s := bufio.NewScanner(io.LimitReader(NewTextReader(nil), 37*10))
+	var count int
+	for s.Scan() {
+		u, err := ParseString(s.Text())
+		if err != nil {
+			t.Fatalf("Unexpected parsing error: %s", err.Error())
+		}
+		verifyVersion(t, u, 4)
+		count++
+	}
+	if err := s.Err(); err != nil {
+		t.Fatalf("Unexpected scanning error: %s", err.Error())
+	}
+	if count != 10 {
+		t.Fatalf("Unexpected number of UUIDs: %d", count)
+	}
+}
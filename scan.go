@@ -0,0 +1,41 @@
+package uuid
+
+import (
+	"fmt"
+	"unicode"
+)
+
+// FmtScanner returns a fmt.Scanner that reads a textual UUID token into u,
+// for use with functions such as fmt.Sscan and fmt.Fscan:
+//
+//	var id uuid.UUID
+//	_, err := fmt.Sscan(line, uuid.FmtScanner(&id))
+//
+// A *UUID cannot implement fmt.Scanner itself, as its Scan method implements
+// the sql Scanner interface. Leading spaces are skipped, and the token may use
+// any representation accepted by Canonicalize. Only the %v and %s verbs are
+// supported.
+func FmtScanner(u *UUID) fmt.Scanner {
+	return fmtScanner{u: u}
+}
+
+type fmtScanner struct {
+	u *UUID
+}
+
+func (s fmtScanner) Scan(state fmt.ScanState, verb rune) error {
+	if verb != 'v' && verb != 's' {
+		return fmt.Errorf("uuid: unsupported scan verb %%%c", verb)
+	}
+	state.SkipSpace()
+	tok, err := state.Token(false, func(r rune) bool { return !unicode.IsSpace(r) })
+	if err != nil {
+		return err
+	}
+	u, err := ParseLenient(string(tok))
+	if err != nil {
+		return err
+	}
+	*s.u = u
+	return nil
+}
@@ -0,0 +1,35 @@
+package uuid
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestFmtScanner(t *testing.T) {
+	u1, u2 := newUUID(), newUUID()
+	line := "  " + u1.String() + "\t{" + strings.ToUpper(u2.String()) + "} 42"
+
+	var out1, out2 UUID
+	var n int
+	count, err := fmt.Sscan(line, FmtScanner(&out1), FmtScanner(&out2), &n)
+	if err != nil {
+		t.Fatalf("Unexpected scan error: %s", err.Error())
+	}
+	if count != 3 || out1 != u1 || out2 != u2 || n != 42 {
+		t.Fatalf("Unexpected scan result: %d, %s, %s, %d", count, out1, out2, n)
+	}
+
+	if _, err = fmt.Sscanf(u1.String(), "%s", FmtScanner(&out1)); err != nil {
+		t.Fatalf("Unexpected scanf error: %s", err.Error())
+	}
+	if _, err = fmt.Sscanf(u1.String(), "%d", FmtScanner(&out1)); err == nil {
+		t.Fatal("Unexpected scanf pass with unsupported verb")
+	}
+	if _, err = fmt.Sscan("invalid", FmtScanner(&out1)); err != ErrInvalidUUID {
+		t.Fatalf("Unexpected scan error: %v", err)
+	}
+	if _, err = fmt.Sscan("", FmtScanner(&out1)); err == nil {
+		t.Fatal("Unexpected scan pass with empty input")
+	}
+}
@@ -0,0 +1,48 @@
+//go:build go1.23
+
+package uuid
+
+import (
+	"context"
+	"iter"
+)
+
+// Seq returns an iterator yielding UUIDs generated by gen until ctx is
+// cancelled or the loop is exited. If gen is nil, NewV4 is used.
+//
+// If gen returns an error, it is yielded along with the zero UUID and the
+// iteration stops:
+//
+//	for u, err := range uuid.Seq(ctx, nil) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func Seq(ctx context.Context, gen func() (UUID, error)) iter.Seq2[UUID, error] {
+	return SeqN(ctx, -1, gen)
+}
+
+// SeqN returns an iterator yielding at most n UUIDs generated by gen, stopping
+// early if ctx is cancelled or the loop is exited. If n is negative, there is
+// no limit. See Seq for more information.
+func SeqN(ctx context.Context, n int, gen func() (UUID, error)) iter.Seq2[UUID, error] {
+	if gen == nil {
+		gen = NewV4
+	}
+	return func(yield func(UUID, error) bool) {
+		for i := 0; n < 0 || i < n; i++ {
+			if ctx.Err() != nil {
+				return
+			}
+			u, err := gen()
+			if err != nil {
+				yield(UUID{}, err)
+				return
+			}
+			if !yield(u, nil) {
+				return
+			}
+		}
+	}
+}
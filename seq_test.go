@@ -0,0 +1,80 @@
+//go:build go1.23
+
+package uuid
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSeq(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var count int
+	for u, err := range Seq(ctx, nil) {
+		if err != nil {
+			t.Fatalf("Unexpected generation error: %s", err.Error())
+		}
+		verifyVersion(t, u, 4)
+		if count++; count == 10 {
+			cancel()
+		}
+	}
+	if count != 10 {
+		t.Fatalf("Unexpected number of UUIDs: %d", count)
+	}
+}
+
+func TestSeqN(t *testing.T) {
+	gen := func() (UUID, error) { return NewV7(time.Now()) }
+
+	var prev UUID
+	var count int
+	for u, err := range SeqN(context.Background(), 100, gen) {
+		if err != nil {
+			t.Fatalf("Unexpected generation error: %s", err.Error())
+		}
+		verifyVersion(t, u, 7)
+		if u == prev {
+			t.Fatalf("Unexpected duplicate UUID: %s", u)
+		}
+		prev = u
+		count++
+	}
+	if count != 100 {
+		t.Fatalf("Unexpected number of UUIDs: %d", count)
+	}
+
+	for range SeqN(context.Background(), 100, gen) {
+		if count++; count == 105 {
+			break
+		}
+	}
+	if count != 105 {
+		t.Fatalf("Unexpected number of UUIDs after break: %d", count)
+	}
+}
+
+func TestSeqError(t *testing.T) {
+	errGen := errors.New("generation failed")
+	var calls, count int
+	gen := func() (UUID, error) {
+		if calls++; calls == 3 {
+			return UUID{}, errGen
+		}
+		return newUUID(), nil
+	}
+	var last error
+	for u, err := range Seq(context.Background(), gen) {
+		count++
+		if last = err; err != nil && !u.IsZero() {
+			t.Fatalf("Unexpected UUID with error: %s", u)
+		}
+	}
+	if count != 3 || last != errGen {
+		t.Fatalf("Unexpected iteration result: %d, %v", count, last)
+	}
+}
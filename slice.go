@@ -0,0 +1,24 @@
+package uuid
+
+// PackSlice returns the provided UUIDs packed into a single, contiguous byte
+// slice of their 16 byte binary representations.
+func PackSlice(ids []UUID) []byte {
+	b := make([]byte, 0, len(ids)*len(UUID{}))
+	for _, u := range ids {
+		b = append(b, u[:]...)
+	}
+	return b
+}
+
+// UnpackSlice returns the UUIDs packed into b by PackSlice. ErrInvalidUUID is
+// returned if the length of b is not a multiple of 16.
+func UnpackSlice(b []byte) ([]UUID, error) {
+	if len(b)%len(UUID{}) != 0 {
+		return nil, ErrInvalidUUID
+	}
+	ids := make([]UUID, len(b)/len(UUID{}))
+	for i := range ids {
+		copy(ids[i][:], b[i*len(UUID{}):])
+	}
+	return ids, nil
+}
@@ -0,0 +1,39 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPackSlice(t *testing.T) {
+	ids := []UUID{newUUID(), newUUID(), newUUID()}
+	b := PackSlice(ids)
+	if len(b) != 48 {
+		t.Fatalf("Unexpected packed length: %d", len(b))
+	}
+	for i, u := range ids {
+		if !bytes.Equal(b[i*16:(i+1)*16], u[:]) {
+			t.Fatalf("Unexpected packed UUID at index %d: %x", i, b[i*16:(i+1)*16])
+		}
+	}
+
+	out, err := UnpackSlice(b)
+	if err != nil {
+		t.Fatalf("Unexpected unpacking error: %s", err.Error())
+	}
+	if len(out) != len(ids) {
+		t.Fatalf("Unexpected number of unpacked UUIDs: %d", len(out))
+	}
+	for i := range ids {
+		if out[i] != ids[i] {
+			t.Fatalf("Unexpected unpacked UUID at index %d: %s", i, out[i])
+		}
+	}
+
+	if b := PackSlice(nil); len(b) != 0 {
+		t.Fatalf("Unexpected packed length for nil slice: %d", len(b))
+	}
+	if _, err = UnpackSlice(b[:47]); err != ErrInvalidUUID {
+		t.Fatalf("Unexpected unpacking error: %v", err)
+	}
+}
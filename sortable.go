@@ -0,0 +1,73 @@
+package uuid
+
+// sortableAlphabet is the lowercase "base32hex" alphabet from RFC 4648. Its
+// characters are in ascending ASCII order, so encoded strings sort in the same
+// order as the bytes they encode.
+const sortableAlphabet = "0123456789abcdefghijklmnopqrstuv"
+
+// sortableLen is the length of a sortable string: 128 bits in 5-bit groups.
+const sortableLen = 26
+
+var sortableValues = func() [256]byte {
+	var t [256]byte
+	for i := range t {
+		t[i] = 0xff
+	}
+	for i := 0; i < len(sortableAlphabet); i++ {
+		t[sortableAlphabet[i]] = byte(i)
+	}
+	return t
+}()
+
+// SortableString returns the UUID encoded as a fixed-length, 26 byte string
+// using the lowercase base32hex alphabet without padding. The lexicographical
+// byte order of sortable strings is identical to that of the binary UUIDs
+// they represent, making them suitable as string sort keys.
+//
+// Example: jpqkttkdr54g7bq3fbl9jfthvo
+func (u UUID) SortableString() string {
+	var buf [sortableLen]byte
+	var acc uint64
+	var n, i int
+	for _, b := range u {
+		acc = acc<<8 | uint64(b)
+		n += 8
+		for n >= 5 {
+			n -= 5
+			buf[i] = sortableAlphabet[acc>>n&0x1f]
+			i++
+		}
+	}
+	buf[i] = sortableAlphabet[acc<<(5-n)&0x1f]
+	return string(buf[:])
+}
+
+// ParseSortable parses the provided string as returned from SortableString,
+// returning the UUID or any error encountered.
+func ParseSortable(s string) (UUID, error) {
+	var u UUID
+	if len(s) != sortableLen {
+		return u, ErrInvalidUUID
+	}
+	var acc uint64
+	var n, i int
+	for j := 0; j < len(s); j++ {
+		v := sortableValues[s[j]]
+		if v == 0xff {
+			return UUID{}, ErrInvalidUUID
+		}
+		acc = acc<<5 | uint64(v)
+		n += 5
+		if n >= 8 {
+			n -= 8
+			u[i] = byte(acc >> n)
+			i++
+		}
+	}
+	// The final character holds 2 unused bits which must be zero, ensuring
+	// each UUID has exactly one sortable string.
+	if acc&(1<<n-1) != 0 {
+		return UUID{}, ErrInvalidUUID
+	}
+	return u, nil
+}
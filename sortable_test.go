@@ -0,0 +1,49 @@
+package uuid
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestSortableString(t *testing.T) {
+	u := Must(ParseString("9e754ef6-8dd9-4903-af43-7aea99bfb1fe"))
+	s := u.SortableString()
+	if s != "jpqkttkdr54g7bq3fbl9jfthvo" {
+		t.Fatalf("Unexpected sortable string: %s", s)
+	}
+	if s := (UUID{}).SortableString(); s != "00000000000000000000000000" {
+		t.Fatalf("Unexpected sortable string: %s", s)
+	}
+
+	for i := 0; i < 1000; i++ {
+		u1, u2 := newUUID(), newUUID()
+		s1, s2 := u1.SortableString(), u2.SortableString()
+		if bytes.Compare(u1[:], u2[:]) != strings.Compare(s1, s2) {
+			t.Fatalf("Sort order differs: %s (%s) vs %s (%s)", u1, s1, u2, s2)
+		}
+	}
+}
+
+func TestParseSortable(t *testing.T) {
+	u1 := newUUID()
+	u2, err := ParseSortable(u1.SortableString())
+	if err != nil {
+		t.Fatalf("Unexpected parsing error: %s", err.Error())
+	}
+	if u1 != u2 {
+		t.Fatalf("Invalid parsed UUID: %s", u2)
+	}
+
+	for _, s := range []string{
+		"",
+		"jpqkttkdr54g7bq3fbl9jfthv",
+		"jpqkttkdr54g7bq3fbl9jfthvw",
+		"JPQKTTKDR54G7BQ3FBL9JFTHVO",
+		"jpqkttkdr54g7bq3fbl9jfthvp",
+	} {
+		if _, err := ParseSortable(s); err != ErrInvalidUUID {
+			t.Fatalf("Unexpected parsing pass: %s", s)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package uuid
+
+import "database/sql/driver"
+
+// BinaryUUID is a UUID that is always stored in a database as its 16 byte
+// binary representation, such as in a BINARY(16) or BYTEA column. As with
+// UUID, the zero UUID is stored as NULL.
+type BinaryUUID UUID
+
+// Value implements the sql driver Valuer interface. It returns the 16 byte
+// binary representation of the UUID, or nil if the UUID is the zero UUID.
+func (b BinaryUUID) Value() (driver.Value, error) {
+	if UUID(b).IsZero() {
+		return nil, nil
+	}
+	return b[:], nil
+}
+
+// StringUUID is a UUID that is always stored in a database as its 36 byte
+// hexadecimal string representation, such as in a CHAR(36) column. As with
+// UUID, the zero UUID is stored as NULL.
+type StringUUID UUID
+
+// Value implements the sql driver Valuer interface. It returns the string
+// representation of the UUID, or nil if the UUID is the zero UUID.
+func (s StringUUID) Value() (driver.Value, error) {
+	if UUID(s).IsZero() {
+		return nil, nil
+	}
+	return UUID(s).String(), nil
+}
@@ -0,0 +1,54 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBinaryUUID(t *testing.T) {
+	u := newUUID()
+	v, err := BinaryUUID(u).Value()
+	if err != nil {
+		t.Fatalf("Unexpected value error: %s", err.Error())
+	}
+	if !bytes.Equal(v.([]byte), u[:]) {
+		t.Fatalf("Unexpected value result: %v", v)
+	}
+
+	var b BinaryUUID
+	if err = b.Scan(v); err != nil {
+		t.Fatalf("Unexpected scan error: %s", err.Error())
+	}
+	if UUID(b) != u {
+		t.Fatalf("Unexpected scan result: %s", b)
+	}
+
+	v, err = BinaryUUID{}.Value()
+	if err != nil || v != nil {
+		t.Fatalf("Unexpected value result for zero UUID: %v, %v", v, err)
+	}
+}
+
+func TestStringUUID(t *testing.T) {
+	u := newUUID()
+	v, err := StringUUID(u).Value()
+	if err != nil {
+		t.Fatalf("Unexpected value error: %s", err.Error())
+	}
+	if v.(string) != u.String() {
+		t.Fatalf("Unexpected value result: %v", v)
+	}
+
+	var s StringUUID
+	if err = s.Scan(v); err != nil {
+		t.Fatalf("Unexpected scan error: %s", err.Error())
+	}
+	if UUID(s) != u {
+		t.Fatalf("Unexpected scan result: %s", s)
+	}
+
+	v, err = StringUUID{}.Value()
+	if err != nil || v != nil {
+		t.Fatalf("Unexpected value result for zero UUID: %v, %v", v, err)
+	}
+}
@@ -0,0 +1,51 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestNewV8Tagged(t *testing.T) {
+	now := time.UnixMilli(1714667854893)
+	for _, tag := range []byte{0x00, 0x2a, 0xff} {
+		u, err := NewV8Tagged(now, tag)
+		if err != nil {
+			t.Fatalf("Unexpected V8 error: %s", err.Error())
+		}
+		verifyVersion(t, u, 8)
+		verifyVariant(t, u)
+		if got := u.Tag(); got != tag {
+			t.Fatalf("Unexpected tag: %#02x", got)
+		}
+		if !bytes.Equal(u[:6], []byte{0x01, 0x8f, 0x3a, 0x2b, 0x1c, 0x2d}) {
+			t.Fatalf("Unexpected timestamp bytes: %x", u[:6])
+		}
+	}
+
+	if _, err := NewV8Tagged(time.UnixMilli(-1), 1); err != ErrTimeOutOfRange {
+		t.Fatalf("Unexpected error for out of range timestamp: %v", err)
+	}
+}
+
+func TestNewV8TaggedFromRand(t *testing.T) {
+	now := time.UnixMilli(1714667854893)
+	u, err := NewV8TaggedFromRand(now, 0x2a, bytes.NewReader(bytes.Repeat([]byte{0xff}, 10)))
+	if err != nil {
+		t.Fatalf("Unexpected V8 error: %s", err.Error())
+	}
+	if s := u.String(); s != "018f3a2b-1c2d-8f2a-bfff-ffffffffffff" {
+		t.Fatalf("Unexpected tagged UUID: %s", s)
+	}
+
+	if _, err = NewV8TaggedFromRand(now, 0x2a, bytes.NewReader(nil)); err == nil {
+		t.Fatal("Unexpected pass with empty reader")
+	}
+}
+
+func TestTag(t *testing.T) {
+	u := Must(ParseString("018f3a2b-1c2d-8f2a-bfff-ffffffffffff"))
+	if tag := u.Tag(); tag != 0x2a {
+		t.Fatalf("Unexpected tag: %#02x", tag)
+	}
+}
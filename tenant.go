@@ -0,0 +1,123 @@
+package uuid
+
+import "time"
+
+// workerIDBits is the number of bits used for a worker ID, placed after the
+// widest counter that a V7Generator may use.
+const workerIDBits = 16
+
+// Config bundles the settings used to generate UUIDs for a tenant in a
+// multi-tenant service. Use Tenant to create an immutable TenantGenerator for
+// a specific tenant.
+type Config struct {
+	// Namespace is the root namespace from which each tenant's namespace is
+	// derived, as a V5 UUID of the tenant name.
+	Namespace UUID
+
+	// Epoch, if non-zero, is the time that V7 style timestamps are relative
+	// to, instead of the Unix epoch. As the embedded timestamps are then no
+	// longer Unix timestamps, such UUIDs are version 8, as required by RFC
+	// 9562, and their timestamps can be read with TenantGenerator.Time.
+	Epoch time.Time
+
+	// WorkerID, if non-zero, is embedded in the 16 bits following the
+	// (up to 26-bit) counter of time-based UUIDs, as permitted by RFC 9562,
+	// section 6.4, reducing the random bits in each UUID.
+	WorkerID uint16
+
+	// Generator is the underlying Generator used to generate UUIDs. If nil,
+	// the default Generator at the time Tenant is called is used.
+	Generator Generator
+}
+
+// TenantGenerator generates UUIDs for a single tenant using the settings of
+// the Config it was created from. It implements Generator and is safe for
+// concurrent use.
+type TenantGenerator struct {
+	tenant    string
+	namespace UUID
+	epoch     time.Time
+	workerID  uint16
+	g         Generator
+}
+
+// Tenant returns a new TenantGenerator for the provided tenant. Changes made
+// to the Config afterwards do not affect the returned generator.
+func (c Config) Tenant(tenant string) *TenantGenerator {
+	g := c.Generator
+	if g == nil {
+		g = Default()
+	}
+	return &TenantGenerator{
+		tenant:    tenant,
+		namespace: NewV5(c.Namespace, []byte(tenant)),
+		epoch:     c.Epoch,
+		workerID:  c.WorkerID,
+		g:         g,
+	}
+}
+
+// Tenant returns the tenant of the generator.
+func (g *TenantGenerator) Tenant() string {
+	return g.tenant
+}
+
+// Namespace returns the namespace of the tenant, used by NewV3 and NewV5.
+func (g *TenantGenerator) Namespace() UUID {
+	return g.namespace
+}
+
+// NewV3 returns a new v3 UUID of the provided name in the tenant's namespace.
+func (g *TenantGenerator) NewV3(name []byte) UUID {
+	return NewV3(g.namespace, name)
+}
+
+// NewV4 generates and returns a new v4 UUID using the underlying Generator.
+func (g *TenantGenerator) NewV4() (UUID, error) {
+	return g.g.NewV4()
+}
+
+// NewV5 returns a new v5 UUID of the provided name in the tenant's namespace.
+func (g *TenantGenerator) NewV5(name []byte) UUID {
+	return NewV5(g.namespace, name)
+}
+
+// NewV7 uses the provided timestamp to generate and return a new time-based
+// UUID using the underlying Generator, embedding the worker ID if configured.
+// If the Config had an Epoch, the returned UUID is version 8.
+func (g *TenantGenerator) NewV7(now time.Time) (UUID, error) {
+	if !g.epoch.IsZero() {
+		now = time.UnixMilli(0).Add(now.Sub(g.epoch))
+	}
+	u, err := g.g.NewV7(now)
+	if err != nil {
+		return UUID{}, err
+	}
+	if g.workerID != 0 {
+		setBits(&u, MaxCounterBits, workerIDBits, uint32(g.workerID))
+	}
+	if !g.epoch.IsZero() {
+		setVersion(&u, 8)
+	}
+	return u, nil
+}
+
+// Time returns the embedded timestamp of a UUID generated by NewV7, and a
+// boolean indicating if a timestamp was successfully parsed, taking the
+// configured Epoch into account.
+func (g *TenantGenerator) Time(u UUID) (time.Time, bool) {
+	if g.epoch.IsZero() {
+		return u.Time()
+	}
+	if u.Version() != 8 {
+		return time.Time{}, false
+	}
+	ms := uint64(u[5]) | uint64(u[4])<<8 | uint64(u[3])<<16 | uint64(u[2])<<24 | uint64(u[1])<<32 | uint64(u[0])<<40
+	return g.epoch.Add(time.Duration(ms) * time.Millisecond), true
+}
+
+// WorkerID returns the worker ID embedded in a UUID generated by NewV7 for a
+// Config with a non-zero WorkerID.
+func WorkerID(u UUID) uint16 {
+	return uint16(getBits(&u, MaxCounterBits, workerIDBits))
+}
@@ -0,0 +1,79 @@
+package uuid
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+	"time"
+)
+
+var _ Generator = (*TenantGenerator)(nil)
+
+func TestTenantGenerator(t *testing.T) {
+	cfg := Config{Namespace: namespaceDNS, WorkerID: 0xbeef}
+	g := cfg.Tenant("acme")
+	cfg.WorkerID = 1
+
+	if g.Tenant() != "acme" {
+		t.Fatalf("Unexpected tenant: %s", g.Tenant())
+	}
+	if g.Namespace() != NewV5(namespaceDNS, []byte("acme")) {
+		t.Fatalf("Unexpected tenant namespace: %s", g.Namespace())
+	}
+	if g.NewV5([]byte("x")) != NewV5(g.Namespace(), []byte("x")) {
+		t.Fatal("Unexpected V5 UUID for tenant")
+	}
+	if g.NewV5([]byte("x")) == cfg.Tenant("other").NewV5([]byte("x")) {
+		t.Fatal("Expected different V5 UUIDs for different tenants")
+	}
+	if !FIPSMode() && g.NewV3([]byte("x")) != NewV3(g.Namespace(), []byte("x")) {
+		t.Fatal("Unexpected V3 UUID for tenant")
+	}
+	verifyVersion(t, Must(g.NewV4()), 4)
+
+	now := time.UnixMilli(time.Now().UnixMilli())
+	u := Must(g.NewV7(now))
+	verifyVariant(t, u)
+	verifyVersion(t, u, 7)
+	if WorkerID(u) != 0xbeef {
+		t.Fatalf("Unexpected worker ID: %x", WorkerID(u))
+	}
+	if ut, ok := g.Time(u); !ok || !ut.Equal(now) {
+		t.Fatalf("Unexpected time: %v", ut)
+	}
+}
+
+func TestTenantGeneratorEpoch(t *testing.T) {
+	epoch := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cfg := Config{
+		Epoch:     epoch,
+		WorkerID:  7,
+		Generator: NewV7Generator(rand.Reader, MinCounterBits, RolloverIncrementTime),
+	}
+	g := cfg.Tenant("acme")
+
+	now := time.UnixMilli(time.Now().UnixMilli())
+	prev := Must(g.NewV7(now))
+	verifyVariant(t, prev)
+	verifyVersion(t, prev, 8)
+	if ut, ok := g.Time(prev); !ok || !ut.Equal(now) {
+		t.Fatalf("Unexpected time: %v", ut)
+	}
+	if ms := now.Sub(epoch).Milliseconds(); prev[5] != byte(ms) || prev[4] != byte(ms>>8) {
+		t.Fatalf("Timestamp not relative to epoch: %s", prev)
+	}
+	for i := 0; i < 100; i++ {
+		u := Must(g.NewV7(now))
+		if WorkerID(u) != 7 {
+			t.Fatalf("Unexpected worker ID: %d", WorkerID(u))
+		}
+		if bytes.Compare(prev[:], u[:]) >= 0 {
+			t.Fatalf("UUIDs not increasing: %s vs %s", prev, u)
+		}
+		prev = u
+	}
+
+	if _, ok := g.Time(newUUID()); ok {
+		t.Fatal("Should not be able to parse time from a V4 UUID")
+	}
+}
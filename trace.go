@@ -0,0 +1,94 @@
+package uuid
+
+import (
+	"encoding/hex"
+	"errors"
+)
+
+// traceparentLen is the length of a version 00 traceparent header.
+const traceparentLen = 55
+
+// ErrInvalidTraceparent represents the error returned when parsing an invalid
+// W3C traceparent header.
+var ErrInvalidTraceparent = errors.New("uuid: invalid traceparent provided")
+
+// TraceID returns the UUID formatted as a 32 byte, lowercase hexadecimal W3C
+// trace-id.
+//
+// Example: 9e754ef68dd94903af437aea99bfb1fe
+func (u UUID) TraceID() string {
+	var buf [32]byte
+	hex.Encode(buf[:], u[:])
+	return string(buf[:])
+}
+
+// Traceparent represents the fields of a W3C Trace Context traceparent header,
+// using a UUID as the trace-id.
+//
+// For more information see: https://www.w3.org/TR/trace-context/#traceparent-header
+type Traceparent struct {
+	TraceID UUID
+	SpanID  [8]byte
+	Flags   byte
+}
+
+// Sampled returns true if the sampled flag is set.
+func (t Traceparent) Sampled() bool {
+	return t.Flags&0x01 != 0
+}
+
+// String returns the version 00 traceparent header value.
+//
+// Example: 00-9e754ef68dd94903af437aea99bfb1fe-00f067aa0ba902b7-01
+func (t Traceparent) String() string {
+	var buf [traceparentLen]byte
+	buf[0], buf[1], buf[2] = '0', '0', dash
+	hex.Encode(buf[3:35], t.TraceID[:])
+	buf[35] = dash
+	hex.Encode(buf[36:52], t.SpanID[:])
+	buf[52] = dash
+	hex.Encode(buf[53:], []byte{t.Flags})
+	return string(buf[:])
+}
+
+// ParseTraceparent parses the provided traceparent header value, returning its
+// fields or ErrInvalidTraceparent. Values with a version other than 00 are
+// parsed as per the specification, ignoring any trailing fields.
+func ParseTraceparent(s string) (Traceparent, error) {
+	var t Traceparent
+	if len(s) < traceparentLen || s[2] != dash || s[35] != dash || s[52] != dash {
+		return t, ErrInvalidTraceparent
+	}
+	var version [1]byte
+	if !decodeLowerHex(version[:], s[:2]) || version[0] == 0xff {
+		return t, ErrInvalidTraceparent
+	}
+	if version[0] == 0 && len(s) != traceparentLen {
+		return t, ErrInvalidTraceparent
+	}
+	if len(s) > traceparentLen && s[traceparentLen] != dash {
+		return t, ErrInvalidTraceparent
+	}
+	var flags [1]byte
+	if !decodeLowerHex(t.TraceID[:], s[3:35]) ||
+		!decodeLowerHex(t.SpanID[:], s[36:52]) ||
+		!decodeLowerHex(flags[:], s[53:55]) {
+		return Traceparent{}, ErrInvalidTraceparent
+	}
+	if t.TraceID.IsZero() || t.SpanID == [8]byte{} {
+		return Traceparent{}, ErrInvalidTraceparent
+	}
+	t.Flags = flags[0]
+	return t, nil
+}
+
+// decodeLowerHex decodes the lowercase hexadecimal src into dst, returning
+// false if src contains any other characters.
+func decodeLowerHex(dst []byte, src string) bool {
+	for i := 0; i < len(src); i++ {
+		if 'A' <= src[i] && src[i] <= 'F' {
+			return false
+		}
+	}
+	return decodeHex(dst, src)
+}
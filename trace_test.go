@@ -0,0 +1,65 @@
+package uuid
+
+import (
+	"fmt"
+	"testing"
+)
+
+var _ fmt.Stringer = Traceparent{}
+
+func TestTraceID(t *testing.T) {
+	u := Must(ParseString("9e754ef6-8dd9-4903-af43-7aea99bfb1fe"))
+	if s := u.TraceID(); s != "9e754ef68dd94903af437aea99bfb1fe" {
+		t.Fatalf("Unexpected trace-id: %s", s)
+	}
+}
+
+func TestTraceparent(t *testing.T) {
+	tp := Traceparent{
+		TraceID: Must(ParseString("9e754ef6-8dd9-4903-af43-7aea99bfb1fe")),
+		SpanID:  [8]byte{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+		Flags:   0x01,
+	}
+	s := tp.String()
+	if s != "00-9e754ef68dd94903af437aea99bfb1fe-00f067aa0ba902b7-01" {
+		t.Fatalf("Unexpected traceparent: %s", s)
+	}
+	if !tp.Sampled() {
+		t.Fatal("Expected traceparent to be sampled")
+	}
+
+	parsed, err := ParseTraceparent(s)
+	if err != nil {
+		t.Fatalf("Unexpected parsing error: %s", err.Error())
+	}
+	if parsed != tp {
+		t.Fatalf("Unexpected parsed traceparent: %+v", parsed)
+	}
+
+	parsed, err = ParseTraceparent("cc-9e754ef68dd94903af437aea99bfb1fe-00f067aa0ba902b7-00-what-the-future-holds")
+	if err != nil {
+		t.Fatalf("Unexpected parsing error for future version: %s", err.Error())
+	}
+	if parsed.TraceID != tp.TraceID || parsed.Sampled() {
+		t.Fatalf("Unexpected parsed traceparent: %+v", parsed)
+	}
+}
+
+func TestParseTraceparentInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"00-9e754ef68dd94903af437aea99bfb1fe-00f067aa0ba902b7-0",
+		"00-9e754ef68dd94903af437aea99bfb1fe-00f067aa0ba902b7-01-",
+		"ff-9e754ef68dd94903af437aea99bfb1fe-00f067aa0ba902b7-01",
+		"00-9E754EF68DD94903AF437AEA99BFB1FE-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-9e754ef68dd94903af437aea99bfb1fe-0000000000000000-01",
+		"00-9e754ef68dd94903af437aea99bfb1fe_00f067aa0ba902b7-01",
+		"cc-9e754ef68dd94903af437aea99bfb1fe-00f067aa0ba902b7-01x",
+		"0g-9e754ef68dd94903af437aea99bfb1fe-00f067aa0ba902b7-01",
+	} {
+		if _, err := ParseTraceparent(s); err != ErrInvalidTraceparent {
+			t.Fatalf("Unexpected parsing pass: %s", s)
+		}
+	}
+}
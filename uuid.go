@@ -26,13 +26,15 @@ package uuid
 
 import (
 	"crypto/md5"
-	"crypto/rand"
 	"crypto/sha1"
+	"crypto/sha256"
 	"database/sql/driver"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"hash"
 	"io"
+	"sync"
 	"time"
 )
 
@@ -51,14 +53,21 @@ func Must(u UUID, err error) UUID {
 
 // NewV3 uses the provided namespace and name to generate and return a new v3
 // UUID using MD5 hashing, as per RFC 4122.
+//
+// NewV3 panics in FIPS mode, as MD5 is not an approved hash function. See
+// FIPSMode for more information.
 func NewV3(namespace UUID, name []byte) UUID {
-	return usingHash(md5.New(), namespace, name, 3)
+	if FIPSMode() {
+		panic("uuid: NewV3 uses MD5, which is not permitted in FIPS mode; use NewV8SHA256")
+	}
+	return usingHash(&md5Pool, namespace, name, 3)
 }
 
 // NewV4 generates and returns a new v4 UUID using random bytes, as per RFC
-// 4122. If an error occurs while reading from "crypto/rand", it is returned.
+// 4122, using the default Generator. If an error occurs while reading from
+// "crypto/rand", it is returned.
 func NewV4() (UUID, error) {
-	return NewV4FromRand(rand.Reader)
+	return Default().NewV4()
 }
 
 // NewV4FromRand generates and returns a new v4 UUID using the random bytes
@@ -75,28 +84,33 @@ func NewV4FromRand(r io.Reader) (UUID, error) {
 
 // NewV5 uses the provided namespace and name to generate and return a new v5
 // UUID using SHA1 hashing, as per RFC 4122.
+//
+// SHA1 should not be used to generate new name-based UUIDs in FIPS-validated
+// environments; prefer NewV8SHA256, reserving NewV5 for compatibility with
+// existing data.
 func NewV5(namespace UUID, name []byte) UUID {
-	return usingHash(sha1.New(), namespace, name, 5)
+	return usingHash(&sha1Pool, namespace, name, 5)
 }
 
 // NewV7 uses the provided timestamp to generate and return a new V7 UUID, as
-// per RFC 4122. If an error occurs while reading from "crypto/rand", it is
-// returned.
+// per RFC 4122, using the default Generator. If an error occurs while reading
+// from "crypto/rand", it is returned.
+//
+// The timestamp must be representable as a 48-bit number of milliseconds
+// since the Unix epoch, otherwise ErrTimeOutOfRange is returned.
 func NewV7(now time.Time) (UUID, error) {
-	return NewV7FromRand(now, rand.Reader)
+	return Default().NewV7(now)
 }
 
 // NewV7FromRand uses the provided timestamp and random io.Reader to return a
 // new V7 UUID, as per RFC 4122.
 func NewV7FromRand(now time.Time, r io.Reader) (UUID, error) {
 	var u UUID
-	ms := uint64(now.UnixMilli())
-	u[0] = byte(ms >> 40)
-	u[1] = byte(ms >> 32)
-	u[2] = byte(ms >> 24)
-	u[3] = byte(ms >> 16)
-	u[4] = byte(ms >> 8)
-	u[5] = byte(ms)
+	ms := now.UnixMilli()
+	if ms < 0 || ms > maxV7Millis {
+		return u, ErrTimeOutOfRange
+	}
+	setMillis(&u, ms)
 	if _, err := io.ReadFull(r, u[6:]); err != nil {
 		return u, err
 	}
@@ -105,6 +119,10 @@ func NewV7FromRand(now time.Time, r io.Reader) (UUID, error) {
 	return u, nil
 }
 
+// maxV7Millis is the largest Unix millisecond timestamp that fits in the 48
+// bits reserved for it in a V7 UUID.
+const maxV7Millis = 1<<48 - 1
+
 const dash = '-'
 
 // Format returns the hexadecimal format of the UUID as an array of 36 bytes.
@@ -153,6 +171,9 @@ func (u UUID) MarshalBinary() ([]byte, error) {
 
 // UnmarshalBinary implements the BinaryUnmarshaler interface. It reads the
 // binary UUID from data into u.
+//
+// Empty data returns ErrInvalidUUID; use OptionalUUID to read it as the zero
+// UUID instead.
 func (u *UUID) UnmarshalBinary(data []byte) error {
 	if len(data) != len(u) {
 		return ErrInvalidUUID
@@ -178,7 +199,7 @@ func (u *UUID) UnmarshalJSON(b []byte) error {
 	if len(b) != 38 || b[0] != '"' || b[37] != '"' {
 		return ErrInvalidUUID
 	}
-	id, err := Parse(b[1:37])
+	id, err := parseFormatted(b[1:37])
 	if err != nil {
 		return err
 	}
@@ -194,6 +215,9 @@ func (u UUID) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the TextUnmarshaler interface. It reads the text
 // UUID from text into u.
+//
+// Empty text returns ErrInvalidUUID; use OptionalUUID to read it as the zero
+// UUID instead.
 func (u *UUID) UnmarshalText(text []byte) error {
 	id, err := Parse(text)
 	if err != nil {
@@ -203,13 +227,29 @@ func (u *UUID) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// UnmarshalParam reads the textual UUID param into u, allowing UUIDs to be
+// bound directly from path and query parameters by frameworks such as echo and
+// gin. Any representation accepted by Canonicalize is supported.
+//
+// An empty param returns ErrInvalidUUID; use OptionalUUID to read it as the
+// zero UUID instead.
+func (u *UUID) UnmarshalParam(param string) error {
+	id, err := ParseLenient(param)
+	if err != nil {
+		return err
+	}
+	*u = id
+	return nil
+}
+
 // IsZero returns true if the UUID cotains all zeros (the default value).
 func (u UUID) IsZero() bool {
 	return u == UUID{}
 }
 
-// Value implements the sql driver Valuer interface. It returns a formatted byte
-// slice representation of the UUID.
+// Value implements the sql driver Valuer interface. It returns the formatted
+// string representation of the UUID, or nil (SQL NULL) if the UUID is the zero
+// UUID, so that unset UUIDs are stored as NULL in nullable columns.
 func (u UUID) Value() (driver.Value, error) {
 	if u.IsZero() {
 		return nil, nil
@@ -218,6 +258,7 @@ func (u UUID) Value() (driver.Value, error) {
 }
 
 // Scan implements the sql Scanner interface. It reads the UUID from src into u.
+// A nil src (SQL NULL) is read as the zero UUID.
 func (u *UUID) Scan(src interface{}) error {
 	var id UUID
 	var err error
@@ -242,6 +283,56 @@ func (u UUID) Version() int {
 	return int(u[6] >> 4)
 }
 
+// SetVersion sets the version bits of the UUID to v, as specified in RFC 9562.
+// Only the lower 4 bits of v are used.
+func (u *UUID) SetVersion(v int) {
+	setVersion(u, byte(v&0x0f))
+}
+
+// SetVariant sets the variant bits of the UUID to '10', the variant specified
+// in RFC 9562.
+func (u *UUID) SetVariant() {
+	setVariant(u)
+}
+
+// NewFromBytesWithVersion returns a new UUID made up of the provided bytes,
+// with the version bits set to v and the variant bits set to '10', as per RFC
+// 9562. It allows for conforming UUIDs to be constructed from custom layouts
+// or sources of entropy. An error wrapping ErrInvalidVersion is returned if v
+// is not in the range [1, 8].
+func NewFromBytesWithVersion(b [16]byte, v int) (UUID, error) {
+	if v < 1 || v > 8 {
+		return UUID{}, fmt.Errorf("%w: %d is not a defined version", ErrInvalidVersion, v)
+	}
+	u := UUID(b)
+	u.SetVersion(v)
+	u.SetVariant()
+	return u, nil
+}
+
+// ValidateVersion returns an error wrapping ErrInvalidVersion if the version of
+// the provided UUID is not equal to want.
+func ValidateVersion(u UUID, want int) error {
+	if v := u.Version(); v != want {
+		return fmt.Errorf("%w: expected version %d, got %d", ErrInvalidVersion, want, v)
+	}
+	return nil
+}
+
+// ParseVersion parses the provided UUID bytes using the same rules as Parse,
+// additionally returning an error wrapping ErrInvalidVersion if the parsed
+// UUID's version is not equal to want.
+func ParseVersion(b []byte, want int) (UUID, error) {
+	u, err := Parse(b)
+	if err != nil {
+		return u, err
+	}
+	if err = ValidateVersion(u, want); err != nil {
+		return UUID{}, err
+	}
+	return u, nil
+}
+
 // Time returns the embedded timestamp of the UUID, and a boolean indicating
 // if a timestamp was successfully parsed.
 //
@@ -254,13 +345,59 @@ func (u UUID) Time() (time.Time, bool) {
 	return time.UnixMilli(int64(ms)), true
 }
 
-// usingHash returns a new UUID using the provided hash function, namespace
-// UUID, name byte slice, and version number.
-func usingHash(h hash.Hash, namespace UUID, name []byte, version byte) UUID {
+// TimeBucket returns the embedded timestamp of the UUID in UTC, rounded down to
+// a multiple of d, and a boolean indicating if a timestamp was successfully
+// parsed. If d <= 0, the timestamp is returned unchanged.
+//
+// The provided UUID MUST be version 7.
+func (u UUID) TimeBucket(d time.Duration) (time.Time, bool) {
+	t, ok := u.Time()
+	if !ok {
+		return t, false
+	}
+	return t.UTC().Truncate(d), true
+}
+
+// PartitionKey returns the embedded timestamp of the UUID in UTC, formatted
+// with the provided time layout, and a boolean indicating if a timestamp was
+// successfully parsed. For example, a layout of "2006-01-02" results in daily
+// partitions, and "2006-01-02T15" in hourly partitions.
+//
+// The provided UUID MUST be version 7.
+func PartitionKey(u UUID, layout string) (string, bool) {
+	t, ok := u.Time()
+	if !ok {
+		return "", false
+	}
+	return t.UTC().Format(layout), true
+}
+
+// hasher holds a reusable hash function along with buffers for its input
+// namespace and output sum, allowing name-based UUIDs to be generated without
+// allocating.
+type hasher struct {
+	h   hash.Hash
+	ns  UUID
+	sum [sha256.Size]byte
+}
+
+var (
+	md5Pool    = sync.Pool{New: func() any { return &hasher{h: md5.New()} }}
+	sha1Pool   = sync.Pool{New: func() any { return &hasher{h: sha1.New()} }}
+	sha256Pool = sync.Pool{New: func() any { return &hasher{h: sha256.New()} }}
+)
+
+// usingHash returns a new UUID using a hash function from the provided pool,
+// namespace UUID, name byte slice, and version number.
+func usingHash(pool *sync.Pool, namespace UUID, name []byte, version byte) UUID {
+	hr := pool.Get().(*hasher)
+	hr.h.Reset()
+	hr.ns = namespace
+	_, _ = hr.h.Write(hr.ns[:])
+	_, _ = hr.h.Write(name)
 	var u UUID
-	_, _ = h.Write(namespace[:])
-	_, _ = h.Write(name)
-	copy(u[:], h.Sum(nil))
+	copy(u[:], hr.h.Sum(hr.sum[:0]))
+	pool.Put(hr)
 	setVersion(&u, version)
 	setVariant(&u)
 	return u
@@ -282,6 +419,15 @@ func setVariant(u *UUID) {
 // bytes do not represent a valid UUID.
 var ErrInvalidUUID = errors.New("uuid: invalid uuid provided")
 
+// ErrInvalidVersion represents the error returned when a UUID does not have
+// the expected version.
+var ErrInvalidVersion = errors.New("uuid: invalid uuid version")
+
+// ErrTimeOutOfRange represents the error returned when generating a V7 UUID
+// with a timestamp before the Unix epoch or beyond the 48-bit millisecond
+// range (the year 10889).
+var ErrTimeOutOfRange = errors.New("uuid: timestamp out of range for v7 uuid")
+
 // Parse parses the provided UUID bytes, returning the UUID or any error
 // encountered. The following formats are provided:
 //
@@ -289,46 +435,96 @@ var ErrInvalidUUID = errors.New("uuid: invalid uuid provided")
 //	32 byte hexadecimal formatted UUID without dashes e.g. 9e754ef68dd94903af437aea99bfb1fe
 //	36 byte hexadecimal formatted UUID e.g "9e754ef6-8dd9-4903-af43-7aea99bfb1fe"
 func Parse(b []byte) (UUID, error) {
+	return parse(b)
+}
+
+// ParseString parses the provided UUID string using the same rules as Parse.
+func ParseString(s string) (UUID, error) {
+	return parse(s)
+}
+
+// parse parses the provided UUID bytes or string, avoiding any intermediate
+// conversions between the two.
+func parse[T []byte | string](b T) (UUID, error) {
+	var u UUID
 	switch len(b) {
 	case 16:
-		var u UUID
 		copy(u[:], b)
 		return u, nil
 	case 32:
-		var u UUID
-		_, err := hex.Decode(u[:], b)
-		if err != nil {
+		if !decodeHex(u[:], b) {
 			return u, ErrInvalidUUID
 		}
 		return u, nil
 	case 36:
 		return parseFormatted(b)
 	default:
-		return UUID{}, ErrInvalidUUID
+		return u, ErrInvalidUUID
 	}
 }
 
-// ParseString parses the provided UUID string using the same rules as Parse.
-func ParseString(s string) (UUID, error) {
-	return Parse([]byte(s))
+// parseText parses the 32 or 36-byte textual UUID b. Unlike parse, the raw
+// 16-byte form is not accepted.
+func parseText[T []byte | string](b T) (UUID, error) {
+	switch len(b) {
+	case 32, 36:
+		return parse(b)
+	default:
+		return UUID{}, ErrInvalidUUID
+	}
 }
 
-var uuidHexLengths = [5]int{8, 4, 4, 4, 12}
+// hexOffsets holds the offset of each pair of hexadecimal characters in the
+// 36-byte formatted UUID.
+var hexOffsets = [16]byte{0, 2, 4, 6, 9, 11, 14, 16, 19, 21, 24, 26, 28, 30, 32, 34}
 
-// parses returns the parsed 36-byte string UUID into a 16-byte UUID.
-func parseFormatted(b []byte) (UUID, error) {
+// parseFormatted parses the 36-byte formatted UUID b into a 16-byte UUID in a
+// single pass.
+func parseFormatted[T []byte | string](b T) (UUID, error) {
 	var u UUID
-	var iu, ib int
-	for idx, cnt := range uuidHexLengths {
-		n, err := hex.Decode(u[iu:], b[ib:ib+cnt])
-		if err != nil {
-			return u, ErrInvalidUUID
-		}
-		if idx < 4 && b[ib+cnt] != dash {
-			return u, ErrInvalidUUID
-		}
-		iu += n
-		ib += cnt + 1
+	if b[8] != dash || b[13] != dash || b[18] != dash || b[23] != dash {
+		return u, ErrInvalidUUID
+	}
+	var invalid byte
+	for i, off := range hexOffsets {
+		hi, lo := hexValues[b[off]], hexValues[b[off+1]]
+		invalid |= hi | lo
+		u[i] = hi<<4 | lo
+	}
+	if invalid&0xf0 != 0 {
+		return UUID{}, ErrInvalidUUID
 	}
 	return u, nil
 }
+
+// decodeHex decodes the hexadecimal src into dst, returning false if src
+// contains any invalid characters. The length of src must be even and dst
+// must be at least half the length of src.
+func decodeHex[T []byte | string](dst []byte, src T) bool {
+	var invalid byte
+	for i := 0; i < len(src); i += 2 {
+		hi, lo := hexValues[src[i]], hexValues[src[i+1]]
+		invalid |= hi | lo
+		dst[i/2] = hi<<4 | lo
+	}
+	return invalid&0xf0 == 0
+}
+
+// hexValues maps each hexadecimal character to its value, and all other bytes
+// to 0xff.
+var hexValues = func() [256]byte {
+	var t [256]byte
+	for i := range t {
+		switch c := byte(i); {
+		case '0' <= c && c <= '9':
+			t[i] = c - '0'
+		case 'a' <= c && c <= 'f':
+			t[i] = c - 'a' + 10
+		case 'A' <= c && c <= 'F':
+			t[i] = c - 'A' + 10
+		default:
+			t[i] = 0xff
+		}
+	}
+	return t
+}()
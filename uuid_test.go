@@ -6,6 +6,8 @@ import (
 	"database/sql/driver"
 	"encoding"
 	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -23,6 +25,9 @@ var (
 )
 
 func TestNewV3(t *testing.T) {
+	if FIPSMode() {
+		t.Skip("NewV3 is not permitted in FIPS mode")
+	}
 	namespace := newUUID()
 	name := []byte("testing")
 
@@ -35,6 +40,16 @@ func TestNewV3(t *testing.T) {
 		t.Fatalf("NewV3 returned different UUIDs with the same namespace & name: %s vs %s",
 			u1.Format(), u2.Format())
 	}
+
+	u3 := NewV3(namespaceDNS, []byte("www.example.com"))
+	if s := u3.String(); s != "5df41881-3aed-3515-88a7-2f4a814cf09e" {
+		t.Fatalf("Unexpected NewV3 result: %s", s)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() { _ = NewV3(namespace, name) })
+	if allocs != 0 {
+		t.Fatalf("Unexpected allocations in NewV3: %v", allocs)
+	}
 }
 
 func TestNewV4(t *testing.T) {
@@ -61,6 +76,16 @@ func TestNewV5(t *testing.T) {
 		t.Fatalf("NewV5 returned different UUIDs with the same namespace & name: %s vs %s",
 			u1.Format(), u2.Format())
 	}
+
+	u3 := NewV5(namespaceDNS, []byte("www.example.com"))
+	if s := u3.String(); s != "2ed6657d-e927-568b-95e1-2665a8aea6a2" {
+		t.Fatalf("Unexpected NewV5 result: %s", s)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() { _ = NewV5(namespace, name) })
+	if allocs != 0 {
+		t.Fatalf("Unexpected allocations in NewV5: %v", allocs)
+	}
 }
 
 func TestVersion(t *testing.T) {
@@ -106,6 +131,9 @@ func TestVersion(t *testing.T) {
 	for i := 0; i < len(table); i++ {
 		ts := table[i]
 		t.Run(ts.name, func(t *testing.T) {
+			if ts.expVersion == 3 && FIPSMode() {
+				t.Skip("NewV3 is not permitted in FIPS mode")
+			}
 			v := ts.u().Version()
 			if v != ts.expVersion {
 				t.Fatalf("Incorrect version: %d", v)
@@ -114,6 +142,81 @@ func TestVersion(t *testing.T) {
 	}
 }
 
+func TestSetVersion(t *testing.T) {
+	var u UUID
+	for i := range u {
+		u[i] = 0xff
+	}
+	u.SetVersion(8)
+	verifyVersion(t, u, 8)
+	if u[6]&0x0f != 0x0f {
+		t.Fatalf("Unexpected change to non-version bits: %x", u[6])
+	}
+	u.SetVariant()
+	verifyVariant(t, u)
+	if u[8]&0x3f != 0x3f {
+		t.Fatalf("Unexpected change to non-variant bits: %x", u[8])
+	}
+}
+
+func TestNewFromBytesWithVersion(t *testing.T) {
+	var b [16]byte
+	for i := range b {
+		b[i] = byte(i)
+	}
+	u, err := NewFromBytesWithVersion(b, 8)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if s := u.String(); s != "00010203-0405-8607-8809-0a0b0c0d0e0f" {
+		t.Fatalf("Unexpected UUID: %s", s)
+	}
+
+	for _, v := range []int{0, 9, -1} {
+		if _, err = NewFromBytesWithVersion(b, v); !errors.Is(err, ErrInvalidVersion) {
+			t.Fatalf("Unexpected error for version %d: %v", v, err)
+		}
+	}
+}
+
+func TestValidateVersion(t *testing.T) {
+	u := newUUID()
+	if err := ValidateVersion(u, 4); err != nil {
+		t.Fatalf("Unexpected validation error: %s", err.Error())
+	}
+	err := ValidateVersion(u, 7)
+	if !errors.Is(err, ErrInvalidVersion) {
+		t.Fatalf("Unexpected validation error: %v", err)
+	}
+	if err.Error() != "uuid: invalid uuid version: expected version 7, got 4" {
+		t.Fatalf("Unexpected validation error message: %s", err.Error())
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	u1 := newUUID()
+	u2, err := ParseVersion(u1.Bytes(), 4)
+	if err != nil {
+		t.Fatalf("Unexpected parsing error: %s", err.Error())
+	}
+	if u1 != u2 {
+		t.Fatalf("Invalid parsed UUID: %s", u2.String())
+	}
+
+	u2, err = ParseVersion(u1.Bytes(), 7)
+	if !errors.Is(err, ErrInvalidVersion) {
+		t.Fatalf("Unexpected parsing error: %v", err)
+	}
+	if !u2.IsZero() {
+		t.Fatalf("Expected zero UUID on error, got: %s", u2.String())
+	}
+
+	_, err = ParseVersion([]byte("bad"), 4)
+	if err != ErrInvalidUUID {
+		t.Fatalf("Unexpected parsing error: %v", err)
+	}
+}
+
 func verifyVariant(t *testing.T, u UUID) {
 	v := u[8] >> 6
 	if v != 2 {
@@ -194,6 +297,10 @@ func TestUnmarshalBinary(t *testing.T) {
 	if err != ErrInvalidUUID {
 		t.Fatalf("Unexpected binary unmarshaling error: %v", err)
 	}
+	err = u2.UnmarshalBinary(nil)
+	if err != ErrInvalidUUID {
+		t.Fatalf("Unexpected binary unmarshaling error for empty data: %v", err)
+	}
 }
 
 func TestMarshalJSON(t *testing.T) {
@@ -229,6 +336,25 @@ func TestUnmarshalJSON(t *testing.T) {
 	if err != ErrInvalidUUID {
 		t.Fatalf("Unexpected json unmarshaling error: %v", err)
 	}
+	for _, s := range []string{
+		`"9e754ef6-8dd9-4903-af43-7aea99bfb1fe`,
+		`"9e754ef6-8dd9-4903-af43-7aea99bfb1f"`,
+		`"9e754ef6-8dd9-4903-af43-7aea99bfb1fe "`,
+		`"9e754ef6-8dd9-4903-af43-7aea99bfb1fz"`,
+		`"9e754ef6-8dd9-4903_af43-7aea99bfb1fe"`,
+		`"9e754ef68dd94903af437aea99bfb1fe"`,
+	} {
+		if err = u2.UnmarshalJSON([]byte(s)); err != ErrInvalidUUID {
+			t.Fatalf("Unexpected json unmarshaling pass: %s", s)
+		}
+	}
+	err = u2.UnmarshalJSON([]byte(`"9E754EF6-8DD9-4903-AF43-7AEA99BFB1FE"`))
+	if err != nil {
+		t.Fatalf("Unexpected json unmarshaling error: %s", err.Error())
+	}
+	if u2.String() != "9e754ef6-8dd9-4903-af43-7aea99bfb1fe" {
+		t.Fatalf("Unexpected json unmarshaling result: %v", u2)
+	}
 }
 
 func TestMarshalText(t *testing.T) {
@@ -257,6 +383,41 @@ func TestUnmarshalText(t *testing.T) {
 	if err != ErrInvalidUUID {
 		t.Fatalf("Unexpected text unmarshaling error: %v", err)
 	}
+	err = u2.UnmarshalText([]byte{})
+	if err != ErrInvalidUUID {
+		t.Fatalf("Unexpected text unmarshaling error for empty text: %v", err)
+	}
+}
+
+func TestUnmarshalParam(t *testing.T) {
+	u1 := newUUID()
+	var table = []string{
+		u1.String(),
+		strings.ToUpper(u1.String()),
+		"{" + u1.String() + "}",
+		"urn:uuid:" + u1.String(),
+		strings.ReplaceAll(u1.String(), "-", ""),
+	}
+	for i := 0; i < len(table); i++ {
+		var u2 UUID
+		if err := u2.UnmarshalParam(table[i]); err != nil {
+			t.Fatalf("Unexpected param unmarshaling error: %s", err.Error())
+		}
+		if u2 != u1 {
+			t.Fatalf("Unexpected param unmarshaling result: %v", u2)
+		}
+	}
+
+	u2 := u1
+	if err := u2.UnmarshalParam(string(u1[:])); err != ErrInvalidUUID {
+		t.Fatalf("Unexpected param unmarshaling error: %v", err)
+	}
+	if err := u2.UnmarshalParam(""); err != ErrInvalidUUID {
+		t.Fatalf("Unexpected param unmarshaling error: %v", err)
+	}
+	if u2 != u1 {
+		t.Fatalf("Unexpected UUID modified after error: %v", u2)
+	}
 }
 
 func TestValue(t *testing.T) {
@@ -309,6 +470,12 @@ func TestScan(t *testing.T) {
 	if !u2.IsZero() {
 		t.Fatalf("Expected UUID to be zero from scanning nil, got: %v", u2)
 	}
+
+	s := u1.String()
+	allocs := testing.AllocsPerRun(100, func() { _ = u2.Scan(s) })
+	if allocs != 0 {
+		t.Fatalf("Unexpected allocations scanning a string: %v", allocs)
+	}
 }
 
 func TestIsEmpty(t *testing.T) {
@@ -332,6 +499,20 @@ func TestParseString(t *testing.T) {
 	if u.String() != s {
 		t.Fatalf("Invalid parsed UUID: %s", u.String())
 	}
+
+	u, err = ParseString("9e754ef68dd94903af437aea99bfb1fe")
+	if err != nil {
+		t.Fatalf("Unexpected parsing error: %s", err.Error())
+	}
+	if u.String() != s {
+		t.Fatalf("Invalid parsed UUID: %s", u.String())
+	}
+
+	for _, bad := range []string{"9e754ef6-8dd9-4903-af43_7aea99bfb1fe", "9e754ef68dd94903af437aea99bfb1fg"} {
+		if _, err := ParseString(bad); err != ErrInvalidUUID {
+			t.Fatalf("Unexpected parsing pass: %s", bad)
+		}
+	}
 }
 
 func TestParse16(t *testing.T) {
@@ -416,6 +597,70 @@ func TestTime(t *testing.T) {
 	}
 }
 
+func TestTimeBucket(t *testing.T) {
+	now := time.Date(2024, 5, 1, 13, 45, 12, 0, time.UTC)
+	u := Must(NewV7(now))
+
+	bucket, ok := u.TimeBucket(time.Hour)
+	if !ok {
+		t.Fatal("Unable to get time bucket from V7 UUID")
+	}
+	if exp := time.Date(2024, 5, 1, 13, 0, 0, 0, time.UTC); !bucket.Equal(exp) {
+		t.Fatalf("Unexpected time bucket: %v", bucket)
+	}
+	if bucket.Location() != time.UTC {
+		t.Fatalf("Unexpected time bucket location: %v", bucket.Location())
+	}
+
+	bucket, _ = u.TimeBucket(24 * time.Hour)
+	if exp := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC); !bucket.Equal(exp) {
+		t.Fatalf("Unexpected time bucket: %v", bucket)
+	}
+
+	if _, ok := newUUID().TimeBucket(time.Hour); ok {
+		t.Fatal("Should not be able to get time bucket from a V4 UUID")
+	}
+}
+
+func TestPartitionKey(t *testing.T) {
+	now := time.Date(2024, 5, 1, 13, 45, 12, 0, time.FixedZone("PDT", -7*60*60))
+	u := Must(NewV7(now))
+
+	key, ok := PartitionKey(u, "2006-01-02T15")
+	if !ok {
+		t.Fatal("Unable to get partition key from V7 UUID")
+	}
+	if key != "2024-05-01T20" {
+		t.Fatalf("Unexpected partition key: %s", key)
+	}
+
+	if _, ok := PartitionKey(newUUID(), "2006-01-02"); ok {
+		t.Fatal("Should not be able to get partition key from a V4 UUID")
+	}
+}
+
+func TestNewV7TimeRange(t *testing.T) {
+	times := []time.Time{
+		time.UnixMilli(-1),
+		time.UnixMilli(maxV7Millis + 1),
+	}
+	for _, tm := range times {
+		_, err := NewV7(tm)
+		if err != ErrTimeOutOfRange {
+			t.Fatalf("Unexpected error for time %v: %v", tm, err)
+		}
+	}
+
+	u, err := NewV7(time.UnixMilli(maxV7Millis))
+	if err != nil {
+		t.Fatalf("Unexpected error generating uuid v7: %s", err.Error())
+	}
+	ut, _ := u.Time()
+	if ut.UnixMilli() != maxV7Millis {
+		t.Fatalf("Unexpected time: %v", ut)
+	}
+}
+
 func TestMust(t *testing.T) {
 	u := Must(NewV4())
 	if u.Version() != 4 {
@@ -423,6 +668,8 @@ func TestMust(t *testing.T) {
 	}
 }
 
+var namespaceDNS = Must(ParseString("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+
 func newUUID() UUID {
 	return Must(NewV4())
 }
@@ -441,7 +688,18 @@ func BenchmarkParseString(b *testing.B) {
 	}
 }
 
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	buf := []byte(`"9e754ef6-8dd9-4903-af43-7aea99bfb1fe"`)
+	var u UUID
+	for i := 0; i < b.N; i++ {
+		_ = u.UnmarshalJSON(buf)
+	}
+}
+
 func BenchmarkNewV3(b *testing.B) {
+	if FIPSMode() {
+		b.Skip("NewV3 is not permitted in FIPS mode")
+	}
 	u := Must(NewV4())
 	name := []byte("test")
 	b.ResetTimer()
@@ -0,0 +1,14 @@
+// Command uuidcheck reports common misuses of the github.com/ryanfowler/uuid
+// package. It may be run directly, or used with go vet:
+//
+//	go vet -vettool=$(which uuidcheck) ./...
+package main
+
+import (
+	"github.com/ryanfowler/uuid/uuidcheck"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(uuidcheck.Analyzer)
+}
@@ -0,0 +1,49 @@
+package a
+
+import (
+	"context"
+	"crypto/rand"
+	"time"
+
+	"github.com/ryanfowler/uuid"
+)
+
+var namespace = uuid.Must(uuid.NewV4())
+
+type other struct{}
+
+func (other) String() string { return "" }
+
+func compare(a, b uuid.UUID, o other) {
+	_ = a.String() == b.String() // want "comparison of UUID String values; compare the UUIDs directly"
+	_ = a.String() != b.String() // want "comparison of UUID String values; compare the UUIDs directly"
+	_ = a == b
+	_ = a.String() == "9e754ef6-8dd9-4903-af43-7aea99bfb1fe"
+	_ = o.String() == o.String()
+}
+
+func namespaces(name []byte) {
+	_ = uuid.NewV5(namespace, name)
+	_ = uuid.NewV5(uuid.Must(uuid.NewV4()), name)                      // want "namespace for NewV5 is randomly generated; use a fixed namespace UUID"
+	_ = uuid.NewV3(uuid.Must(uuid.NewV7(time.Now())), name)            // want "namespace for NewV3 is randomly generated; use a fixed namespace UUID"
+	_ = uuid.NewV5(uuid.UUID{}, name)                                  // want "namespace for NewV5 is the zero UUID; use a unique namespace UUID"
+	_ = uuid.NewV8SHA256(uuid.Must(uuid.NewOrdered(time.Now())), name) // want "namespace for NewV8SHA256 is randomly generated; use a fixed namespace UUID"
+	_ = uuid.NewV8SHA256(uuid.UUID{}, name)                            // want "namespace for NewV8SHA256 is the zero UUID; use a unique namespace UUID"
+}
+
+func errors() error {
+	u, _ := uuid.NewV4() // want "error returned by uuid.NewV4 is ignored"
+	_ = u
+	u, _ = uuid.NewV7FromRand(time.Now(), rand.Reader)          // want "error returned by uuid.NewV7FromRand is ignored"
+	uuid.NewV4FromRand(rand.Reader)                             // want "result of uuid.NewV4FromRand is not used"
+	u, _ = uuid.NewV4Context(context.Background())              // want "error returned by uuid.NewV4Context is ignored"
+	u, _ = uuid.NewV7Context(context.Background(), time.Now())  // want "error returned by uuid.NewV7Context is ignored"
+	u, _ = uuid.NewV7Opts(time.Now())                           // want "error returned by uuid.NewV7Opts is ignored"
+	u, _ = uuid.NewV8Tagged(time.Now(), 1)                      // want "error returned by uuid.NewV8Tagged is ignored"
+	u, _ = uuid.NewV8TaggedFromRand(time.Now(), 1, rand.Reader) // want "error returned by uuid.NewV8TaggedFromRand is ignored"
+	u, _ = uuid.NewOrdered(time.Now())                          // want "error returned by uuid.NewOrdered is ignored"
+	uuid.NewOrderedFromRand(time.Now(), rand.Reader)            // want "result of uuid.NewOrderedFromRand is not used"
+	u, err := uuid.NewV7(time.Now())
+	_ = u
+	return err
+}
@@ -0,0 +1,11 @@
+package fips
+
+import "github.com/ryanfowler/uuid"
+
+var ns = uuid.UUID{0x6b, 0xa7, 0xb8, 0x10}
+
+func _() {
+	_ = uuid.NewV3(ns, []byte("name")) // want `uuid.NewV3 uses MD5, which is not permitted in FIPS mode; use uuid.NewV8SHA256`
+	_ = uuid.NewV5(ns, []byte("name")) // want `uuid.NewV5 uses SHA-1, which should not be used for new data in FIPS mode; use uuid.NewV8SHA256`
+	_ = uuid.NewV8SHA256(ns, []byte("name"))
+}
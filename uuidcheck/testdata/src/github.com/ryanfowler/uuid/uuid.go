@@ -0,0 +1,43 @@
+package uuid
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+type UUID [16]byte
+
+func Must(u UUID, err error) UUID { return u }
+
+func NewV3(namespace UUID, name []byte) UUID { return UUID{} }
+
+func NewV4() (UUID, error) { return UUID{}, nil }
+
+func NewV4FromRand(r io.Reader) (UUID, error) { return UUID{}, nil }
+
+func NewV5(namespace UUID, name []byte) UUID { return UUID{} }
+
+func NewV7(now time.Time) (UUID, error) { return UUID{}, nil }
+
+func NewV7FromRand(now time.Time, r io.Reader) (UUID, error) { return UUID{}, nil }
+
+func (u UUID) String() string { return "" }
+
+func NewV8SHA256(namespace UUID, name []byte) UUID { return UUID{} }
+
+func NewV4Context(ctx context.Context) (UUID, error) { return UUID{}, nil }
+
+func NewV7Context(ctx context.Context, now time.Time) (UUID, error) { return UUID{}, nil }
+
+type V7Option func()
+
+func NewV7Opts(now time.Time, opts ...V7Option) (UUID, error) { return UUID{}, nil }
+
+func NewV8Tagged(now time.Time, tag byte) (UUID, error) { return UUID{}, nil }
+
+func NewV8TaggedFromRand(now time.Time, tag byte, r io.Reader) (UUID, error) { return UUID{}, nil }
+
+func NewOrdered(now time.Time) (UUID, error) { return UUID{}, nil }
+
+func NewOrderedFromRand(now time.Time, r io.Reader) (UUID, error) { return UUID{}, nil }
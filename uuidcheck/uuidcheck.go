@@ -0,0 +1,216 @@
+// Package uuidcheck provides an analyzer that reports common misuses of the
+// github.com/ryanfowler/uuid package.
+//
+// The following patterns are reported:
+//
+//   - comparing the String values of two UUIDs instead of the UUIDs
+//     themselves, which allocates and is easily broken by formatting changes;
+//   - using a randomly generated or zero UUID as the namespace for NewV3,
+//     NewV5, or NewV8SHA256, which makes the name-based UUIDs
+//     non-reproducible or collide with those of other applications;
+//   - ignoring the error returned by a generator of random or time-based
+//     UUIDs, such as NewV4, NewV7, NewV7Opts, NewV8Tagged, or NewOrdered,
+//     which may result in a partially random UUID being used.
+//
+// With the -fips flag, calls to NewV3 and NewV5 are also reported, as MD5 is
+// not permitted and SHA-1 should not be used for new data in FIPS-validated
+// environments. NewV8SHA256 should be used instead.
+package uuidcheck
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const uuidPath = "github.com/ryanfowler/uuid"
+
+// Analyzer reports common misuses of the github.com/ryanfowler/uuid package.
+var Analyzer = &analysis.Analyzer{
+	Name:     "uuidcheck",
+	Doc:      "report common misuses of the github.com/ryanfowler/uuid package",
+	URL:      "https://pkg.go.dev/github.com/ryanfowler/uuid/uuidcheck",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// fips enables reporting of calls to NewV3 and NewV5.
+var fips bool
+
+func init() {
+	Analyzer.Flags.BoolVar(&fips, "fips", false, "report name-based UUIDs using hash functions not approved for FIPS mode")
+}
+
+// generators are the functions that return an error which must be checked.
+var generators = map[string]bool{
+	"NewV4":               true,
+	"NewV4Context":        true,
+	"NewV4FromRand":       true,
+	"NewV7":               true,
+	"NewV7Context":        true,
+	"NewV7FromRand":       true,
+	"NewV7Opts":           true,
+	"NewV8Tagged":         true,
+	"NewV8TaggedFromRand": true,
+	"NewOrdered":          true,
+	"NewOrderedFromRand":  true,
+}
+
+// nameBased are the functions that generate a UUID from a namespace and name.
+var nameBased = map[string]bool{
+	"NewV3":       true,
+	"NewV5":       true,
+	"NewV8SHA256": true,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if pass.Pkg.Path() == uuidPath {
+		// The uuid package is free to use its own functions as it likes.
+		return nil, nil
+	}
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	filter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.BinaryExpr)(nil),
+		(*ast.CallExpr)(nil),
+		(*ast.ExprStmt)(nil),
+	}
+	insp.Preorder(filter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			checkAssign(pass, n)
+		case *ast.BinaryExpr:
+			checkCompare(pass, n)
+		case *ast.CallExpr:
+			checkNamespace(pass, n)
+			if fips {
+				checkFIPS(pass, n)
+			}
+		case *ast.ExprStmt:
+			if call, ok := ast.Unparen(n.X).(*ast.CallExpr); ok && isGenerator(pass, call) {
+				pass.Reportf(call.Pos(), "result of %s is not used", calleeName(pass, call))
+			}
+		}
+	})
+	return nil, nil
+}
+
+// checkAssign reports assignments that discard the error of a generator.
+func checkAssign(pass *analysis.Pass, n *ast.AssignStmt) {
+	if len(n.Lhs) != 2 || len(n.Rhs) != 1 {
+		return
+	}
+	call, ok := ast.Unparen(n.Rhs[0]).(*ast.CallExpr)
+	if !ok || !isGenerator(pass, call) {
+		return
+	}
+	if id, ok := n.Lhs[1].(*ast.Ident); ok && id.Name == "_" {
+		pass.Reportf(id.Pos(), "error returned by %s is ignored", calleeName(pass, call))
+	}
+}
+
+// checkCompare reports comparisons between the String values of two UUIDs.
+func checkCompare(pass *analysis.Pass, n *ast.BinaryExpr) {
+	if n.Op != token.EQL && n.Op != token.NEQ {
+		return
+	}
+	if isUUIDString(pass, n.X) && isUUIDString(pass, n.Y) {
+		pass.Reportf(n.OpPos, "comparison of UUID String values; compare the UUIDs directly")
+	}
+}
+
+// checkNamespace reports calls to name-based generators using a randomly
+// generated or zero UUID as the namespace.
+func checkNamespace(pass *analysis.Pass, call *ast.CallExpr) {
+	fn := uuidFunc(pass, call)
+	if fn == nil || !nameBased[fn.Name()] || len(call.Args) != 2 {
+		return
+	}
+	ns := ast.Unparen(call.Args[0])
+	if inner, ok := ns.(*ast.CallExpr); ok {
+		if f := uuidFunc(pass, inner); f != nil && f.Name() == "Must" && len(inner.Args) == 1 {
+			ns = ast.Unparen(inner.Args[0])
+		}
+	}
+	switch ns := ns.(type) {
+	case *ast.CallExpr:
+		if isGenerator(pass, ns) {
+			pass.Reportf(ns.Pos(), "namespace for %s is randomly generated; use a fixed namespace UUID", fn.Name())
+		}
+	case *ast.CompositeLit:
+		if len(ns.Elts) == 0 && isUUIDType(pass.TypesInfo.TypeOf(ns)) {
+			pass.Reportf(ns.Pos(), "namespace for %s is the zero UUID; use a unique namespace UUID", fn.Name())
+		}
+	}
+}
+
+// checkFIPS reports calls to NewV3 and NewV5, which use hash functions that
+// are not approved for generating new data in FIPS mode.
+func checkFIPS(pass *analysis.Pass, call *ast.CallExpr) {
+	fn := uuidFunc(pass, call)
+	if fn == nil {
+		return
+	}
+	switch fn.Name() {
+	case "NewV3":
+		pass.Reportf(call.Pos(), "uuid.NewV3 uses MD5, which is not permitted in FIPS mode; use uuid.NewV8SHA256")
+	case "NewV5":
+		pass.Reportf(call.Pos(), "uuid.NewV5 uses SHA-1, which should not be used for new data in FIPS mode; use uuid.NewV8SHA256")
+	}
+}
+
+// isGenerator returns true if call is a call to one of the generators.
+func isGenerator(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn := uuidFunc(pass, call)
+	return fn != nil && generators[fn.Name()]
+}
+
+// isUUIDString returns true if expr is a call to the String method of a UUID.
+func isUUIDString(pass *analysis.Pass, expr ast.Expr) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Name() != "String" {
+		return false
+	}
+	recv := fn.Type().(*types.Signature).Recv()
+	return recv != nil && isUUIDType(recv.Type())
+}
+
+// uuidFunc returns the package-level function of the uuid package called by
+// call, or nil.
+func uuidFunc(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != uuidPath {
+		return nil
+	}
+	if fn.Type().(*types.Signature).Recv() != nil {
+		return nil
+	}
+	return fn
+}
+
+// calleeName returns the qualified name of the uuid function called by call.
+func calleeName(pass *analysis.Pass, call *ast.CallExpr) string {
+	return "uuid." + uuidFunc(pass, call).Name()
+}
+
+// isUUIDType returns true if t is, or points to, the uuid.UUID type.
+func isUUIDType(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Name() == "UUID" && obj.Pkg() != nil && obj.Pkg().Path() == uuidPath
+}
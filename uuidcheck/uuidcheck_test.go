@@ -0,0 +1,21 @@
+package uuidcheck_test
+
+import (
+	"testing"
+
+	"github.com/ryanfowler/uuid/uuidcheck"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), uuidcheck.Analyzer, "a")
+}
+
+func TestAnalyzerFIPS(t *testing.T) {
+	if err := uuidcheck.Analyzer.Flags.Set("fips", "true"); err != nil {
+		t.Fatalf("Unexpected flag error: %s", err.Error())
+	}
+	defer uuidcheck.Analyzer.Flags.Set("fips", "false")
+
+	analysistest.Run(t, analysistest.TestData(), uuidcheck.Analyzer, "fips")
+}
@@ -0,0 +1,13 @@
+module github.com/ryanfowler/uuid/uuidconv
+
+go 1.20
+
+require (
+	github.com/gofrs/uuid/v5 v5.3.0
+	github.com/google/uuid v1.6.0
+	github.com/ryanfowler/uuid v1.0.0
+)
+
+// The replace directive only applies when developing within this repository;
+// dependents resolve the version required above.
+replace github.com/ryanfowler/uuid => ../
@@ -0,0 +1,32 @@
+// Package uuidconv provides conversions between uuid.UUID and the UUID types
+// of github.com/google/uuid and github.com/gofrs/uuid/v5.
+//
+// It is provided as a separate module to avoid adding either library as a
+// dependency of github.com/ryanfowler/uuid.
+package uuidconv
+
+import (
+	gofrs "github.com/gofrs/uuid/v5"
+	google "github.com/google/uuid"
+	"github.com/ryanfowler/uuid"
+)
+
+// FromGoogle returns the uuid.UUID equivalent of the provided google UUID.
+func FromGoogle(u google.UUID) uuid.UUID {
+	return uuid.UUID(u)
+}
+
+// ToGoogle returns the google UUID equivalent of the provided uuid.UUID.
+func ToGoogle(u uuid.UUID) google.UUID {
+	return google.UUID(u)
+}
+
+// FromGofrs returns the uuid.UUID equivalent of the provided gofrs UUID.
+func FromGofrs(u gofrs.UUID) uuid.UUID {
+	return uuid.UUID(u)
+}
+
+// ToGofrs returns the gofrs UUID equivalent of the provided uuid.UUID.
+func ToGofrs(u uuid.UUID) gofrs.UUID {
+	return gofrs.UUID(u)
+}
@@ -0,0 +1,43 @@
+package uuidconv
+
+import (
+	"testing"
+
+	gofrs "github.com/gofrs/uuid/v5"
+	google "github.com/google/uuid"
+	"github.com/ryanfowler/uuid"
+)
+
+const testUUID = "9e754ef6-8dd9-4903-af43-7aea99bfb1fe"
+
+func TestGoogle(t *testing.T) {
+	u := uuid.Must(uuid.ParseString(testUUID))
+
+	g := ToGoogle(u)
+	if s := g.String(); s != testUUID {
+		t.Fatalf("Unexpected google UUID: %s", s)
+	}
+	if back := FromGoogle(g); back != u {
+		t.Fatalf("Unexpected round-tripped UUID: %s", back)
+	}
+
+	if s := FromGoogle(google.MustParse(testUUID)).String(); s != testUUID {
+		t.Fatalf("Unexpected converted UUID: %s", s)
+	}
+}
+
+func TestGofrs(t *testing.T) {
+	u := uuid.Must(uuid.ParseString(testUUID))
+
+	g := ToGofrs(u)
+	if s := g.String(); s != testUUID {
+		t.Fatalf("Unexpected gofrs UUID: %s", s)
+	}
+	if back := FromGofrs(g); back != u {
+		t.Fatalf("Unexpected round-tripped UUID: %s", back)
+	}
+
+	if s := FromGofrs(gofrs.Must(gofrs.FromString(testUUID))).String(); s != testUUID {
+		t.Fatalf("Unexpected converted UUID: %s", s)
+	}
+}
@@ -0,0 +1,14 @@
+module github.com/ryanfowler/uuid/uuidzap
+
+go 1.20
+
+require (
+	github.com/ryanfowler/uuid v1.0.0
+	go.uber.org/zap v1.27.0
+)
+
+require go.uber.org/multierr v1.10.0 // indirect
+
+// The replace directive only applies when developing within this repository;
+// dependents resolve the version required above.
+replace github.com/ryanfowler/uuid => ../
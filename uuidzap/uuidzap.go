@@ -0,0 +1,59 @@
+// Package uuidzap provides helpers for efficiently logging UUIDs with
+// go.uber.org/zap.
+//
+// It is provided as a separate module to avoid adding zap as a dependency of
+// github.com/ryanfowler/uuid.
+package uuidzap
+
+import (
+	"github.com/ryanfowler/uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// UUID returns a zap.Field with the provided key and UUID. The UUID is only
+// formatted if the log entry is written.
+func UUID(key string, u uuid.UUID) zap.Field {
+	return zap.Stringer(key, u)
+}
+
+// UUIDs returns a zap.Field with the provided key and UUIDs, encoded as an
+// array of strings. The UUIDs are only formatted if the log entry is written.
+func UUIDs(key string, us []uuid.UUID) zap.Field {
+	return zap.Array(key, Array(us))
+}
+
+// ObjectField returns a zap.Field with the provided key and the UUID encoded as an
+// object. See Object for more information.
+func ObjectField(key string, u uuid.UUID) zap.Field {
+	return zap.Object(key, Object(u))
+}
+
+// Array is a slice of UUIDs implementing zapcore.ArrayMarshaler.
+type Array []uuid.UUID
+
+// MarshalLogArray implements the zapcore.ArrayMarshaler interface.
+func (a Array) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, u := range a {
+		b := u.Format()
+		enc.AppendByteString(b[:])
+	}
+	return nil
+}
+
+// Object is a UUID implementing zapcore.ObjectMarshaler. It is encoded as an
+// object with the fields "uuid" and "version", along with "time" for UUIDs
+// with an embedded timestamp.
+type Object uuid.UUID
+
+// MarshalLogObject implements the zapcore.ObjectMarshaler interface.
+func (o Object) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	u := uuid.UUID(o)
+	b := u.Format()
+	enc.AddByteString("uuid", b[:])
+	enc.AddInt("version", u.Version())
+	if t, ok := u.Time(); ok {
+		enc.AddTime("time", t)
+	}
+	return nil
+}
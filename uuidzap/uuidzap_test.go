@@ -0,0 +1,46 @@
+package uuidzap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryanfowler/uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+var (
+	_ zapcore.ArrayMarshaler  = Array(nil)
+	_ zapcore.ObjectMarshaler = Object{}
+)
+
+func TestFields(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	logger := zap.New(core)
+
+	u1 := uuid.Must(uuid.NewV4())
+	u2 := uuid.Must(uuid.NewV7(time.UnixMilli(1000)))
+	logger.Info("test",
+		UUID("id", u1),
+		UUIDs("ids", []uuid.UUID{u1, u2}),
+		ObjectField("obj", u2),
+	)
+
+	entries := logs.AllUntimed()
+	if len(entries) != 1 {
+		t.Fatalf("Unexpected number of log entries: %d", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if fields["id"] != u1.String() {
+		t.Fatalf("Unexpected id field: %v", fields["id"])
+	}
+	ids := fields["ids"].([]interface{})
+	if len(ids) != 2 || ids[0] != u1.String() || ids[1] != u2.String() {
+		t.Fatalf("Unexpected ids field: %v", fields["ids"])
+	}
+	obj := fields["obj"].(map[string]interface{})
+	if obj["uuid"] != u2.String() || obj["version"] != 7 || !obj["time"].(time.Time).Equal(time.UnixMilli(1000)) {
+		t.Fatalf("Unexpected obj field: %v", obj)
+	}
+}
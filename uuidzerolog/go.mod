@@ -0,0 +1,18 @@
+module github.com/ryanfowler/uuid/uuidzerolog
+
+go 1.20
+
+require (
+	github.com/rs/zerolog v1.33.0
+	github.com/ryanfowler/uuid v1.0.0
+)
+
+require (
+	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-isatty v0.0.19 // indirect
+	golang.org/x/sys v0.12.0 // indirect
+)
+
+// The replace directive only applies when developing within this repository;
+// dependents resolve the version required above.
+replace github.com/ryanfowler/uuid => ../
@@ -0,0 +1,45 @@
+// Package uuidzerolog provides helpers for efficiently logging UUIDs with
+// github.com/rs/zerolog.
+//
+// It is provided as a separate module to avoid adding zerolog as a dependency
+// of github.com/ryanfowler/uuid.
+package uuidzerolog
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/ryanfowler/uuid"
+)
+
+// UUID adds the field key with the UUID formatted as a string to the event,
+// without allocating an intermediate string.
+func UUID(e *zerolog.Event, key string, u uuid.UUID) *zerolog.Event {
+	b := u.Format()
+	return e.Bytes(key, b[:])
+}
+
+// Array is a slice of UUIDs implementing zerolog.LogArrayMarshaler, for use
+// with Event.Array.
+type Array []uuid.UUID
+
+// MarshalZerologArray implements the zerolog.LogArrayMarshaler interface.
+func (a Array) MarshalZerologArray(arr *zerolog.Array) {
+	for _, u := range a {
+		b := u.Format()
+		arr.Bytes(b[:])
+	}
+}
+
+// Object is a UUID implementing zerolog.LogObjectMarshaler, for use with
+// Event.Object. It is encoded as an object with the fields "uuid" and
+// "version", along with "time" for UUIDs with an embedded timestamp.
+type Object uuid.UUID
+
+// MarshalZerologObject implements the zerolog.LogObjectMarshaler interface.
+func (o Object) MarshalZerologObject(e *zerolog.Event) {
+	u := uuid.UUID(o)
+	UUID(e, "uuid", u)
+	e.Int("version", u.Version())
+	if t, ok := u.Time(); ok {
+		e.Time("time", t)
+	}
+}
@@ -0,0 +1,46 @@
+package uuidzerolog
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/ryanfowler/uuid"
+)
+
+var (
+	_ zerolog.LogArrayMarshaler  = Array(nil)
+	_ zerolog.LogObjectMarshaler = Object{}
+)
+
+func TestHelpers(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+
+	u1 := uuid.Must(uuid.ParseString("9e754ef6-8dd9-4903-af43-7aea99bfb1fe"))
+	u2 := uuid.Must(uuid.ParseString("00000000-03e8-7a8a-a9d1-a1a8468eaff4"))
+	UUID(logger.Info(), "id", u1).
+		Array("ids", Array{u1, u2}).
+		Object("obj", Object(u2)).
+		Msg("test")
+
+	exp := `{"level":"info","id":"9e754ef6-8dd9-4903-af43-7aea99bfb1fe",` +
+		`"ids":["9e754ef6-8dd9-4903-af43-7aea99bfb1fe","00000000-03e8-7a8a-a9d1-a1a8468eaff4"],` +
+		`"obj":{"uuid":"00000000-03e8-7a8a-a9d1-a1a8468eaff4","version":7,"time":"` +
+		time.UnixMilli(1000).Format(time.RFC3339) + `"},"message":"test"}` + "\n"
+	if buf.String() != exp {
+		t.Fatalf("Unexpected log output: %s", buf.String())
+	}
+}
+
+func TestUUIDAllocs(t *testing.T) {
+	logger := zerolog.New(nil)
+	u := uuid.Must(uuid.NewV4())
+	allocs := testing.AllocsPerRun(100, func() {
+		UUID(logger.Info(), "id", u).Send()
+	})
+	if allocs != 0 {
+		t.Fatalf("Unexpected allocations: %v", allocs)
+	}
+}
@@ -0,0 +1,34 @@
+package uuid
+
+import "fmt"
+
+// IndexError records a failure to parse the element at Index of a slice.
+type IndexError struct {
+	Index int
+	Value string
+	Err   error
+}
+
+// Error implements the error interface.
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("uuid: index %d: %s", e.Index, e.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateAll validates every element of ss as a textual UUID, accepting the
+// same representations as Canonicalize, returning an *IndexError for each
+// invalid element in the order they appear. It returns nil if all elements
+// are valid.
+func ValidateAll(ss []string) []error {
+	var errs []error
+	for i, s := range ss {
+		if _, err := ParseLenient(s); err != nil {
+			errs = append(errs, &IndexError{Index: i, Value: s, Err: err})
+		}
+	}
+	return errs
+}
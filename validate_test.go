@@ -0,0 +1,42 @@
+package uuid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateAll(t *testing.T) {
+	if errs := ValidateAll(nil); errs != nil {
+		t.Fatalf("Unexpected errors: %v", errs)
+	}
+	if errs := ValidateAll([]string{newUUID().String(), newUUID().String()}); errs != nil {
+		t.Fatalf("Unexpected errors: %v", errs)
+	}
+
+	ss := []string{"invalid", newUUID().String(), "", newUUID().String(), "9e754ef6-8dd9-4903-af43-7aea99bfb1fz"}
+	errs := ValidateAll(ss)
+	if len(errs) != 3 {
+		t.Fatalf("Unexpected number of errors: %d", len(errs))
+	}
+	for i, idx := range []int{0, 2, 4} {
+		var ie *IndexError
+		if !errors.As(errs[i], &ie) {
+			t.Fatalf("Unexpected error type: %T", errs[i])
+		}
+		if ie.Index != idx || ie.Value != ss[idx] {
+			t.Fatalf("Unexpected index error: %d, %q", ie.Index, ie.Value)
+		}
+		if !errors.Is(errs[i], ErrInvalidUUID) {
+			t.Fatalf("Unexpected error: %s", errs[i].Error())
+		}
+	}
+	if s := errs[1].Error(); s != "uuid: index 2: uuid: invalid uuid provided" {
+		t.Fatalf("Unexpected error string: %s", s)
+	}
+
+	// 16-byte strings must not be accepted as binary UUIDs.
+	errs = ValidateAll([]string{"hello world!!!!!"})
+	if len(errs) != 1 || !errors.Is(errs[0], ErrInvalidUUID) {
+		t.Fatalf("Unexpected errors for 16-byte string: %v", errs)
+	}
+}
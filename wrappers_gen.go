@@ -0,0 +1,290 @@
+// Code generated by gen_wrappers.go. DO NOT EDIT.
+
+package uuid
+
+import "database/sql/driver"
+
+// String returns the human-readable, hexadecimal format of the UUID.
+func (id ID[T]) String() string {
+	return UUID(id).String()
+}
+
+// IsZero returns true if the UUID contains all zeros (the default value).
+func (id ID[T]) IsZero() bool {
+	return UUID(id).IsZero()
+}
+
+// MarshalText implements the TextMarshaler interface.
+func (id ID[T]) MarshalText() ([]byte, error) {
+	return UUID(id).MarshalText()
+}
+
+// UnmarshalText implements the TextUnmarshaler interface.
+func (id *ID[T]) UnmarshalText(text []byte) error {
+	return (*UUID)(id).UnmarshalText(text)
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (id ID[T]) MarshalJSON() ([]byte, error) {
+	return UUID(id).MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (id *ID[T]) UnmarshalJSON(data []byte) error {
+	return (*UUID)(id).UnmarshalJSON(data)
+}
+
+// MarshalBinary implements the BinaryMarshaler interface.
+func (id ID[T]) MarshalBinary() ([]byte, error) {
+	return UUID(id).MarshalBinary()
+}
+
+// UnmarshalBinary implements the BinaryUnmarshaler interface.
+func (id *ID[T]) UnmarshalBinary(data []byte) error {
+	return (*UUID)(id).UnmarshalBinary(data)
+}
+
+// Value implements the sql driver Valuer interface.
+func (id ID[T]) Value() (driver.Value, error) {
+	return UUID(id).Value()
+}
+
+// Scan implements the sql Scanner interface.
+func (id *ID[T]) Scan(src interface{}) error {
+	return (*UUID)(id).Scan(src)
+}
+
+// String returns the human-readable, hexadecimal format of the UUID.
+func (b BinaryUUID) String() string {
+	return UUID(b).String()
+}
+
+// IsZero returns true if the UUID contains all zeros (the default value).
+func (b BinaryUUID) IsZero() bool {
+	return UUID(b).IsZero()
+}
+
+// MarshalText implements the TextMarshaler interface.
+func (b BinaryUUID) MarshalText() ([]byte, error) {
+	return UUID(b).MarshalText()
+}
+
+// UnmarshalText implements the TextUnmarshaler interface.
+func (b *BinaryUUID) UnmarshalText(text []byte) error {
+	return (*UUID)(b).UnmarshalText(text)
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (b BinaryUUID) MarshalJSON() ([]byte, error) {
+	return UUID(b).MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (b *BinaryUUID) UnmarshalJSON(data []byte) error {
+	return (*UUID)(b).UnmarshalJSON(data)
+}
+
+// MarshalBinary implements the BinaryMarshaler interface.
+func (b BinaryUUID) MarshalBinary() ([]byte, error) {
+	return UUID(b).MarshalBinary()
+}
+
+// UnmarshalBinary implements the BinaryUnmarshaler interface.
+func (b *BinaryUUID) UnmarshalBinary(data []byte) error {
+	return (*UUID)(b).UnmarshalBinary(data)
+}
+
+// Scan implements the sql Scanner interface.
+func (b *BinaryUUID) Scan(src interface{}) error {
+	return (*UUID)(b).Scan(src)
+}
+
+// String returns the human-readable, hexadecimal format of the UUID.
+func (s StringUUID) String() string {
+	return UUID(s).String()
+}
+
+// IsZero returns true if the UUID contains all zeros (the default value).
+func (s StringUUID) IsZero() bool {
+	return UUID(s).IsZero()
+}
+
+// MarshalText implements the TextMarshaler interface.
+func (s StringUUID) MarshalText() ([]byte, error) {
+	return UUID(s).MarshalText()
+}
+
+// UnmarshalText implements the TextUnmarshaler interface.
+func (s *StringUUID) UnmarshalText(text []byte) error {
+	return (*UUID)(s).UnmarshalText(text)
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (s StringUUID) MarshalJSON() ([]byte, error) {
+	return UUID(s).MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (s *StringUUID) UnmarshalJSON(data []byte) error {
+	return (*UUID)(s).UnmarshalJSON(data)
+}
+
+// MarshalBinary implements the BinaryMarshaler interface.
+func (s StringUUID) MarshalBinary() ([]byte, error) {
+	return UUID(s).MarshalBinary()
+}
+
+// UnmarshalBinary implements the BinaryUnmarshaler interface.
+func (s *StringUUID) UnmarshalBinary(data []byte) error {
+	return (*UUID)(s).UnmarshalBinary(data)
+}
+
+// Scan implements the sql Scanner interface.
+func (s *StringUUID) Scan(src interface{}) error {
+	return (*UUID)(s).Scan(src)
+}
+
+// String returns the human-readable, hexadecimal format of the UUID.
+func (d DecimalUUID) String() string {
+	return UUID(d).String()
+}
+
+// IsZero returns true if the UUID contains all zeros (the default value).
+func (d DecimalUUID) IsZero() bool {
+	return UUID(d).IsZero()
+}
+
+// MarshalText implements the TextMarshaler interface.
+func (d DecimalUUID) MarshalText() ([]byte, error) {
+	return UUID(d).MarshalText()
+}
+
+// UnmarshalText implements the TextUnmarshaler interface.
+func (d *DecimalUUID) UnmarshalText(text []byte) error {
+	return (*UUID)(d).UnmarshalText(text)
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (d DecimalUUID) MarshalJSON() ([]byte, error) {
+	return UUID(d).MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (d *DecimalUUID) UnmarshalJSON(data []byte) error {
+	return (*UUID)(d).UnmarshalJSON(data)
+}
+
+// MarshalBinary implements the BinaryMarshaler interface.
+func (d DecimalUUID) MarshalBinary() ([]byte, error) {
+	return UUID(d).MarshalBinary()
+}
+
+// UnmarshalBinary implements the BinaryUnmarshaler interface.
+func (d *DecimalUUID) UnmarshalBinary(data []byte) error {
+	return (*UUID)(d).UnmarshalBinary(data)
+}
+
+// String returns the human-readable, hexadecimal format of the UUID.
+func (r RequiredUUID) String() string {
+	return UUID(r).String()
+}
+
+// IsZero returns true if the UUID contains all zeros (the default value).
+func (r RequiredUUID) IsZero() bool {
+	return UUID(r).IsZero()
+}
+
+// MarshalText implements the TextMarshaler interface.
+func (r RequiredUUID) MarshalText() ([]byte, error) {
+	return UUID(r).MarshalText()
+}
+
+// UnmarshalText implements the TextUnmarshaler interface.
+func (r *RequiredUUID) UnmarshalText(text []byte) error {
+	return (*UUID)(r).UnmarshalText(text)
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (r RequiredUUID) MarshalJSON() ([]byte, error) {
+	return UUID(r).MarshalJSON()
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (r *RequiredUUID) UnmarshalJSON(data []byte) error {
+	return (*UUID)(r).UnmarshalJSON(data)
+}
+
+// MarshalBinary implements the BinaryMarshaler interface.
+func (r RequiredUUID) MarshalBinary() ([]byte, error) {
+	return UUID(r).MarshalBinary()
+}
+
+// UnmarshalBinary implements the BinaryUnmarshaler interface.
+func (r *RequiredUUID) UnmarshalBinary(data []byte) error {
+	return (*UUID)(r).UnmarshalBinary(data)
+}
+
+// Value implements the sql driver Valuer interface.
+func (r RequiredUUID) Value() (driver.Value, error) {
+	return UUID(r).Value()
+}
+
+// Scan implements the sql Scanner interface.
+func (r *RequiredUUID) Scan(src interface{}) error {
+	return (*UUID)(r).Scan(src)
+}
+
+// String returns the human-readable, hexadecimal format of the UUID.
+func (o OptionalUUID) String() string {
+	return UUID(o).String()
+}
+
+// IsZero returns true if the UUID contains all zeros (the default value).
+func (o OptionalUUID) IsZero() bool {
+	return UUID(o).IsZero()
+}
+
+// Value implements the sql driver Valuer interface.
+func (o OptionalUUID) Value() (driver.Value, error) {
+	return UUID(o).Value()
+}
+
+// String returns the human-readable, hexadecimal format of the UUID.
+func (c CompactUUID) String() string {
+	return UUID(c).String()
+}
+
+// IsZero returns true if the UUID contains all zeros (the default value).
+func (c CompactUUID) IsZero() bool {
+	return UUID(c).IsZero()
+}
+
+// MarshalText implements the TextMarshaler interface.
+func (c CompactUUID) MarshalText() ([]byte, error) {
+	return UUID(c).MarshalText()
+}
+
+// UnmarshalText implements the TextUnmarshaler interface.
+func (c *CompactUUID) UnmarshalText(text []byte) error {
+	return (*UUID)(c).UnmarshalText(text)
+}
+
+// MarshalBinary implements the BinaryMarshaler interface.
+func (c CompactUUID) MarshalBinary() ([]byte, error) {
+	return UUID(c).MarshalBinary()
+}
+
+// UnmarshalBinary implements the BinaryUnmarshaler interface.
+func (c *CompactUUID) UnmarshalBinary(data []byte) error {
+	return (*UUID)(c).UnmarshalBinary(data)
+}
+
+// Value implements the sql driver Valuer interface.
+func (c CompactUUID) Value() (driver.Value, error) {
+	return UUID(c).Value()
+}
+
+// Scan implements the sql Scanner interface.
+func (c *CompactUUID) Scan(src interface{}) error {
+	return (*UUID)(c).Scan(src)
+}
@@ -0,0 +1,122 @@
+package uuid
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+// wrapperValue is the value method set shared by UUID and its wrapper types.
+type wrapperValue interface {
+	fmt.Stringer
+	IsZero() bool
+	encoding.TextMarshaler
+	json.Marshaler
+	encoding.BinaryMarshaler
+	driver.Valuer
+}
+
+// wrapper is the full method set shared by a pointer to UUID and its wrapper
+// types.
+type wrapper interface {
+	wrapperValue
+	encoding.TextUnmarshaler
+	json.Unmarshaler
+	encoding.BinaryUnmarshaler
+	sql.Scanner
+}
+
+var (
+	_ wrapperValue = UUID{}
+	_ wrapperValue = ID[testUser]{}
+	_ wrapperValue = BinaryUUID{}
+	_ wrapperValue = StringUUID{}
+	_ wrapperValue = DecimalUUID{}
+	_ wrapperValue = RequiredUUID{}
+	_ wrapperValue = OptionalUUID{}
+	_ wrapperValue = CompactUUID{}
+)
+
+var wrapperTable = []struct {
+	name string
+	wrap func(UUID) wrapper
+}{
+	{"UUID", func(u UUID) wrapper { return &u }},
+	{"ID", func(u UUID) wrapper { w := ID[testUser](u); return &w }},
+	{"BinaryUUID", func(u UUID) wrapper { w := BinaryUUID(u); return &w }},
+	{"StringUUID", func(u UUID) wrapper { w := StringUUID(u); return &w }},
+	{"DecimalUUID", func(u UUID) wrapper { w := DecimalUUID(u); return &w }},
+	{"RequiredUUID", func(u UUID) wrapper { w := RequiredUUID(u); return &w }},
+	{"OptionalUUID", func(u UUID) wrapper { w := OptionalUUID(u); return &w }},
+	{"CompactUUID", func(u UUID) wrapper { w := CompactUUID(u); return &w }},
+}
+
+func TestWrappers(t *testing.T) {
+	for i := 0; i < len(wrapperTable); i++ {
+		wt := wrapperTable[i]
+		t.Run(wt.name, func(t *testing.T) {
+			u := newUUID()
+			w := wt.wrap(u)
+			if w.String() != u.String() {
+				t.Fatalf("Unexpected string: %s", w.String())
+			}
+			if w.IsZero() || !wt.wrap(UUID{}).IsZero() {
+				t.Fatal("Unexpected IsZero result")
+			}
+
+			var table = []struct {
+				name      string
+				marshal   func(w wrapper) (interface{}, error)
+				unmarshal func(w wrapper, v interface{}) error
+			}{
+				{
+					name:      "text",
+					marshal:   func(w wrapper) (interface{}, error) { return w.MarshalText() },
+					unmarshal: func(w wrapper, v interface{}) error { return w.UnmarshalText(v.([]byte)) },
+				},
+				{
+					name:      "json",
+					marshal:   func(w wrapper) (interface{}, error) { return json.Marshal(w) },
+					unmarshal: func(w wrapper, v interface{}) error { return json.Unmarshal(v.([]byte), w) },
+				},
+				{
+					name:      "binary",
+					marshal:   func(w wrapper) (interface{}, error) { return w.MarshalBinary() },
+					unmarshal: func(w wrapper, v interface{}) error { return w.UnmarshalBinary(v.([]byte)) },
+				},
+				{
+					name:      "sql",
+					marshal:   func(w wrapper) (interface{}, error) { return w.Value() },
+					unmarshal: func(w wrapper, v interface{}) error { return w.Scan(v) },
+				},
+			}
+			for j := 0; j < len(table); j++ {
+				v, err := table[j].marshal(w)
+				if err != nil {
+					t.Fatalf("Unexpected %s marshaling error: %s", table[j].name, err.Error())
+				}
+				out := wt.wrap(UUID{})
+				if err = table[j].unmarshal(out, v); err != nil {
+					t.Fatalf("Unexpected %s unmarshaling error: %s", table[j].name, err.Error())
+				}
+				if out.String() != u.String() {
+					t.Fatalf("Unexpected %s round trip result: %s", table[j].name, out.String())
+				}
+			}
+
+			v, err := w.Value()
+			if err != nil || !driver.IsValue(v) {
+				t.Fatalf("Unexpected sql value: %v, %v", v, err)
+			}
+			if v, _ = wt.wrap(UUID{}).Value(); v != nil {
+				t.Fatalf("Unexpected sql value for zero UUID: %v", v)
+			}
+			if err = w.Scan(nil); err != nil || !w.IsZero() {
+				t.Fatalf("Unexpected scan result for nil: %s, %v", w, err)
+			}
+		})
+	}
+}